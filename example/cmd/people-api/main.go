@@ -26,7 +26,7 @@ func main() {
 	converterv1 := overridev1.Converter{servicev1.NewConverter()}
 	impl := &private.Service{Store: make(map[string]*privatepb.Person)}
 	srv := grpc.NewServer()
-	servicepb.RegisterServer(srv, impl, converterv1)
+	servicepb.RegisterServer(srv, impl, converterv1, overridev1.FieldConverters(), overridev1.UpsertHook{})
 
 	log.Printf("listening on address: %s", ln.Addr())
 	if err := srv.Serve(ln); err != nil {
@@ -1,13 +1,41 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	overridev1 "github.com/dane/protoc-gen-go-svc/example/override/v1"
+	privatepb "github.com/dane/protoc-gen-go-svc/example/proto/go/private"
 	service "github.com/dane/protoc-gen-go-svc/example/proto/go/service"
+	serviceprivate "github.com/dane/protoc-gen-go-svc/example/proto/go/service/private"
+	testingprivate "github.com/dane/protoc-gen-go-svc/example/proto/go/service/private/testing"
+	servicetesting "github.com/dane/protoc-gen-go-svc/example/proto/go/service/testing"
 	servicev1 "github.com/dane/protoc-gen-go-svc/example/proto/go/service/v1"
 	testingv1 "github.com/dane/protoc-gen-go-svc/example/proto/go/service/v1/testing"
+	servicev2 "github.com/dane/protoc-gen-go-svc/example/proto/go/service/v2"
 	testingv2 "github.com/dane/protoc-gen-go-svc/example/proto/go/service/v2/testing"
+	v1pb "github.com/dane/protoc-gen-go-svc/example/proto/go/v1"
+	v2pb "github.com/dane/protoc-gen-go-svc/example/proto/go/v2"
+	private "github.com/dane/protoc-gen-go-svc/example/service/private"
 )
 
 func TestV2(t *testing.T) {
@@ -25,6 +53,16 @@ func TestV2(t *testing.T) {
 				PrivateOutput: "testdata/conversions/private/create-response-v2.json",
 			},
 		},
+		{
+			Fn: testingv2.NewCreateConversionTest,
+			Params: testingv2.Params{
+				PublicInput:   "testdata/conversions/v2/create-request.json",
+				PublicOutput:  "testdata/conversions/v2/create-response.json",
+				PrivateInput:  "testdata/conversions/private/create-request-v2.json",
+				PrivateOutput: "testdata/conversions/private/create-response-v2.json",
+				Transport:     testingv2.TransportDirect,
+			},
+		},
 		{
 			Fn: testingv2.NewBatchConversionTest,
 			Params: testingv2.Params{
@@ -65,3 +103,1351 @@ func TestV1(t *testing.T) {
 		test.Fn(t, test.Params, test.Options)
 	}
 }
+
+func TestV2Record(t *testing.T) {
+	dir := t.TempDir()
+	params := testingv2.Params{
+		PublicInput:   "testdata/conversions/v2/create-request.json",
+		PublicOutput:  filepath.Join(dir, "v2", "create-response.json"),
+		PrivateInput:  filepath.Join(dir, "private", "create-request-v2.json"),
+		PrivateOutput: "testdata/conversions/private/create-response-v2.json",
+		Update:        true,
+	}
+
+	testingv2.NewCreateConversionTest(t, params, nil)
+
+	tests := map[string]struct {
+		Got  string
+		Want string
+		Msg  proto.Message
+	}{
+		"public output": {
+			Got:  params.PublicOutput,
+			Want: "testdata/conversions/v2/create-response.json",
+			Msg:  &v2pb.CreateResponse{},
+		},
+		"private input": {
+			Got:  params.PrivateInput,
+			Want: "testdata/conversions/private/create-request-v2.json",
+			Msg:  &privatepb.CreateRequest{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, want := proto.Clone(test.Msg), proto.Clone(test.Msg)
+			for fileName, dst := range map[string]proto.Message{test.Got: got, test.Want: want} {
+				b, err := os.ReadFile(fileName)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if err := protojson.Unmarshal(b, dst); err != nil {
+					t.Fatalf("%s: %s", fileName, err)
+				}
+			}
+
+			if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+				t.Fatal(diff)
+			}
+		})
+	}
+
+	// Recorded fixtures pass once record mode is disabled.
+	params.Update = false
+	testingv2.NewCreateConversionTest(t, params, nil)
+}
+
+// fixturesDir is the directory of the conversion fixtures of every version.
+const fixturesDir = "testdata/fixtures"
+
+// skippedFixtures are the methods without conversion fixtures.
+var skippedFixtures = []string{
+	"v2/Get", "v2/Delete", "v2/Update", "v2/Ping", "v2/Search",
+	"v1/Get", "v1/Delete", "v1/List", "v1/Ping", "v1/Search",
+}
+
+func TestRun(t *testing.T) {
+	servicetesting.Run(t, servicetesting.RunParams{Dir: fixturesDir, Skip: skippedFixtures}, []service.Option{
+		overridev1.Converter{servicev1.NewConverter()},
+	})
+}
+
+func TestMissingFixtures(t *testing.T) {
+	tests := map[string]struct {
+		Params servicetesting.RunParams
+		Want   []string
+	}{
+		"skipped": {
+			Params: servicetesting.RunParams{Dir: fixturesDir, Skip: skippedFixtures},
+		},
+		"method without fixtures": {
+			Params: servicetesting.RunParams{Dir: fixturesDir, Skip: skippedFixtures[1:]},
+			Want:   []string{"v2/Get"},
+		},
+		"version without fixtures": {
+			Params: servicetesting.RunParams{Dir: t.TempDir(), Skip: skippedFixtures},
+			Want:   []string{"v2/Create", "v2/Batch", "v1/Create"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := servicetesting.MissingFixtures(test.Params)
+			if diff := cmp.Diff(test.Want, got); diff != "" {
+				t.Fatalf("unexpected missing fixtures (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFake(t *testing.T) {
+	var (
+		publicIn   v2pb.CreateRequest
+		publicOut  v2pb.CreateResponse
+		privateIn  privatepb.CreateRequest
+		privateOut privatepb.CreateResponse
+	)
+
+	files := map[string]proto.Message{
+		"testdata/conversions/v2/create-request.json":          &publicIn,
+		"testdata/conversions/v2/create-response.json":         &publicOut,
+		"testdata/conversions/private/create-request-v2.json":  &privateIn,
+		"testdata/conversions/private/create-response-v2.json": &privateOut,
+	}
+
+	for fileName, dst := range files {
+		b, err := os.ReadFile(fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := protojson.Unmarshal(b, dst); err != nil {
+			t.Fatalf("%s: %s", fileName, err)
+		}
+	}
+
+	fake := &testingprivate.Fake{}
+	fake.OnCreate(&privateOut, nil)
+	fake.OnDelete(nil, status.Error(codes.NotFound, "person not found"))
+
+	conn := servicetesting.StartServer(t, fake, nil)
+	ctx := context.Background()
+
+	out, err := v2pb.NewPeopleClient(conn).Create(ctx, &publicIn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(&publicOut, out, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected output (-want +got):\n%s", diff)
+	}
+
+	fake.AssertCreateCalled(t, &privateIn)
+
+	// Errors of the private service are returned by every version.
+	_, err = v1pb.NewPeopleClient(conn).Delete(ctx, &v1pb.DeleteRequest{Id: "4b0b4e4d-8e1d-4b4a-9c53-6d0e0e3c9a5f"})
+	if code := status.Code(err); code != codes.NotFound {
+		t.Fatalf("unexpected code %s", code)
+	}
+
+	fake.AssertCallCount(t, "Delete", 1)
+
+	// Methods without a response are unimplemented.
+	_, err = v2pb.NewPeopleClient(conn).Get(ctx, &v2pb.GetRequest{Id: "4b0b4e4d-8e1d-4b4a-9c53-6d0e0e3c9a5f"})
+	if code := status.Code(err); code != codes.Unimplemented {
+		t.Fatalf("unexpected code %s", code)
+	}
+
+	if got := len(fake.Calls()); got != 3 {
+		t.Fatalf("unexpected calls %d", got)
+	}
+}
+
+func TestV1FieldConverters(t *testing.T) {
+	converter := overridev1.Converter{servicev1.NewConverter()}
+	fields := overridev1.FieldConverters()
+
+	b, err := os.ReadFile("testdata/fixtures/v1/Create/person/private-out.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var created privatepb.CreateResponse
+	if err := protojson.Unmarshal(b, &created); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string][]service.Option{
+		"fields before converter": {fields, converter},
+		"fields after converter":  {converter, fields},
+	}
+
+	for name, options := range tests {
+		t.Run(name, func(t *testing.T) {
+			fake := &testingprivate.Fake{}
+			fake.OnList(&privatepb.ListResponse{}, nil)
+			fake.OnCreate(&created, nil)
+
+			conn := servicetesting.StartServer(t, fake, options)
+			client := v1pb.NewPeopleClient(conn)
+			ctx := context.Background()
+
+			if _, err := client.List(ctx, &v1pb.ListRequest{CreatedAfter: "2021-11-21", Limit: 10}); err != nil {
+				t.Fatal(err)
+			}
+
+			want := timestamppb.New(time.Date(2021, 11, 21, 0, 0, 0, 0, time.UTC))
+			if diff := cmp.Diff(want, fake.ListCalls()[0].CreatedAfter, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected created after (-want +got):\n%s", diff)
+			}
+
+			_, err := client.Create(ctx, &v1pb.CreateRequest{
+				Id:         "f95616f1-23e3-4694-8658-8082b0a18267",
+				FirstName:  "Dane",
+				LastName:   "Harrigan",
+				Employment: v1pb.Person_EMPLOYED,
+				Hobby: &v1pb.Hobby{
+					Type: &v1pb.Hobby_Biking{Biking: &v1pb.Biking{Style: "road"}},
+				},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The converter option applies whatever the order of the options.
+			if got := fake.CreateCalls()[0].Age; got != 36 {
+				t.Fatalf("unexpected age %d", got)
+			}
+
+			_, err = client.List(ctx, &v1pb.ListRequest{CreatedAfter: "21/11/2021", Limit: 10})
+			if code := status.Code(err); code != codes.InvalidArgument {
+				t.Fatalf("unexpected code %s", code)
+			}
+		})
+	}
+}
+
+func FuzzV2Create(f *testing.F) {
+	testingv2.FuzzCreate(f, nil)
+}
+
+func FuzzV2Update(f *testing.F) {
+	testingv2.FuzzUpdate(f, nil)
+}
+
+func FuzzV1Create(f *testing.F) {
+	testingv1.FuzzCreate(f, []service.Option{
+		overridev1.Converter{servicev1.NewConverter()},
+	})
+}
+
+func FuzzV1Get(f *testing.F) {
+	testingv1.FuzzGet(f, nil)
+}
+
+type errorImpl struct {
+	privatepb.UnimplementedPeopleServer
+	err error
+}
+
+func (e errorImpl) Create(ctx context.Context, in *privatepb.CreateRequest) (*privatepb.CreateResponse, error) {
+	return nil, e.err
+}
+
+func TestV1Errors(t *testing.T) {
+	st, err := status.New(codes.InvalidArgument, "invalid").WithDetails(
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "hobby.cycling.style", Description: "unknown style"},
+				{Field: "id", Description: "already exists"},
+			},
+		},
+		&errdetails.ErrorInfo{
+			Reason:   "INVALID_HOBBY",
+			Metadata: map[string]string{"field": "hobby.cycling", "resource": "hobby.cycling"},
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svcPrivate := &serviceprivate.Service{
+		Validator: serviceprivate.NewValidator(),
+		Impl:      errorImpl{err: st.Err()},
+	}
+
+	svcV2 := &servicev2.Service{
+		Validator: servicev2.NewValidator(),
+		Converter: servicev2.NewConverter(),
+		Private:   svcPrivate,
+	}
+
+	svcV1 := &servicev1.Service{
+		Validator: servicev1.NewValidator(),
+		Converter: overridev1.Converter{Converter: servicev1.NewConverter()},
+		Private:   svcPrivate,
+		Next:      svcV2,
+	}
+
+	in := &v1pb.CreateRequest{
+		Id:         "4b0b4e4d-8e1d-4b4a-9c53-6d0e0e3c9a5f",
+		FirstName:  "Jane",
+		LastName:   "Doe",
+		Employment: v1pb.Person_EMPLOYED,
+		Hobby: &v1pb.Hobby{
+			Type: &v1pb.Hobby_Biking{Biking: &v1pb.Biking{Style: "road"}},
+		},
+	}
+
+	_, _, err = svcV1.CreateImpl(context.Background(), in)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+
+	got := status.Convert(err)
+	if got.Code() != codes.InvalidArgument {
+		t.Fatalf("unexpected code %s", got.Code())
+	}
+
+	want := []interface{}{
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "hobby.biking.style", Description: "unknown style"},
+				{Field: "id", Description: "already exists"},
+			},
+		},
+		&errdetails.ErrorInfo{
+			Reason:   "INVALID_HOBBY",
+			// Only the values of field path keys are rewritten.
+			Metadata: map[string]string{"field": "hobby.biking", "resource": "hobby.cycling"},
+		},
+	}
+
+	if diff := cmp.Diff(want, got.Details(), protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected details (-want +got):\n%s", diff)
+	}
+}
+
+type listImpl struct {
+	privatepb.UnimplementedPeopleServer
+	req *privatepb.ListRequest
+}
+
+func (l *listImpl) List(ctx context.Context, in *privatepb.ListRequest) (*privatepb.ListResponse, error) {
+	l.req = in
+	return &privatepb.ListResponse{NextPageToken: "next"}, nil
+}
+
+func TestV1Pagination(t *testing.T) {
+	impl := &listImpl{}
+	svcPrivate := &serviceprivate.Service{
+		Validator: serviceprivate.NewValidator(),
+		Impl:      impl,
+	}
+
+	svcV1 := &servicev1.Service{
+		Validator:      servicev1.NewValidator(),
+		Converter:      servicev1.NewConverterWithFieldConverters(overridev1.FieldConverters()),
+		Private:        svcPrivate,
+		PageTokenCodec: servicev1.NewPageTokenCodec(),
+	}
+
+	if _, err := svcV1.List(context.Background(), &v1pb.ListRequest{Offset: 20, Limit: 10}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &privatepb.ListRequest{
+		PageSize:  10,
+		PageToken: servicev1.NewPageTokenCodec().Encode(20),
+	}
+
+	if diff := cmp.Diff(want, impl.req, protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected private request (-want +got):\n%s", diff)
+	}
+
+	offset, err := svcV1.PageTokenCodec.Decode(impl.req.PageToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if offset != 20 {
+		t.Fatalf("expected offset 20, got %d", offset)
+	}
+
+	_, err = svcV1.List(context.Background(), &v1pb.ListRequest{Limit: 101})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+}
+
+func TestV2Pagination(t *testing.T) {
+	store := make(map[string]*privatepb.Person)
+	for _, id := range []string{"1", "2", "3"} {
+		store[id] = &privatepb.Person{Id: id, FullName: "Jane Doe " + id}
+	}
+
+	// The service is not created by `RegisterServer`, so the default page
+	// token codec is used.
+	svcV2 := &servicev2.Service{
+		Validator: servicev2.NewValidator(),
+		Converter: servicev2.NewConverter(),
+		Private: &serviceprivate.Service{
+			Validator: serviceprivate.NewValidator(),
+			Impl:      &private.Service{Store: store},
+		},
+	}
+
+	tests := map[string]struct {
+		PageSize int32
+		Pages    [][]string
+	}{
+		"full pages": {
+			PageSize: 2,
+			Pages:    [][]string{{"1", "2"}, {"3"}},
+		},
+		"default page size": {
+			PageSize: 0,
+			Pages:    [][]string{{"1", "2", "3"}, nil},
+		},
+		"single page": {
+			PageSize: 3,
+			Pages:    [][]string{{"1", "2", "3"}, nil},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var pages [][]string
+			var token string
+			for {
+				out, err := svcV2.Search(context.Background(), &v2pb.SearchRequest{
+					PageSize:  test.PageSize,
+					PageToken: token,
+					Query:     "Jane",
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				var ids []string
+				for _, person := range out.People {
+					ids = append(ids, person.Id)
+				}
+
+				pages = append(pages, ids)
+				if token = out.NextPageToken; token == "" {
+					break
+				}
+			}
+
+			if diff := cmp.Diff(test.Pages, pages); diff != "" {
+				t.Fatalf("unexpected pages (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type createImpl struct {
+	privatepb.UnimplementedPeopleServer
+	req *privatepb.CreateRequest
+}
+
+func (c *createImpl) Create(ctx context.Context, in *privatepb.CreateRequest) (*privatepb.CreateResponse, error) {
+	c.req = in
+	return nil, status.Error(codes.Unavailable, "unavailable")
+}
+
+func TestV1Presence(t *testing.T) {
+	impl := &createImpl{}
+	svcPrivate := &serviceprivate.Service{
+		Validator: serviceprivate.NewValidator(),
+		Impl:      impl,
+	}
+
+	svcV2 := &servicev2.Service{
+		Validator: servicev2.NewValidator(),
+		Converter: servicev2.NewConverter(),
+		Private:   svcPrivate,
+	}
+
+	svcV1 := &servicev1.Service{
+		Validator: servicev1.NewValidator(),
+		Converter: overridev1.Converter{Converter: servicev1.NewConverter()},
+		Private:   svcPrivate,
+		Next:      svcV2,
+	}
+
+	tests := []struct {
+		Name     string
+		Nickname *wrapperspb.StringValue
+		Want     *string
+	}{
+		{Name: "unset"},
+		{Name: "zero", Nickname: wrapperspb.String(""), Want: proto.String("")},
+		{Name: "value", Nickname: wrapperspb.String("jd"), Want: proto.String("jd")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			in := &v1pb.CreateRequest{
+				Id:         "4b0b4e4d-8e1d-4b4a-9c53-6d0e0e3c9a5f",
+				FirstName:  "Jane",
+				LastName:   "Doe",
+				Employment: v1pb.Person_EMPLOYED,
+				Hobby: &v1pb.Hobby{
+					Type: &v1pb.Hobby_Coding{Coding: &v1pb.Coding{Language: "Go"}},
+				},
+				Nickname: test.Nickname,
+			}
+
+			if _, err := svcV1.Create(context.Background(), in); status.Code(err) != codes.Unavailable {
+				t.Fatalf("unexpected error %v", err)
+			}
+
+			if diff := cmp.Diff(test.Want, impl.req.Nickname); diff != "" {
+				t.Fatalf("unexpected private nickname (-want +got):\n%s", diff)
+			}
+
+			person, err := servicev1.NewConverter().ToDeprecatedPublicPerson(&privatepb.Person{
+				Id:         in.Id,
+				FirstName:  in.FirstName,
+				LastName:   in.LastName,
+				Employment: privatepb.Person_FULL_TIME,
+				Nickname:   test.Want,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.Nickname, person.Nickname, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected public nickname (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestV2BytesPresence(t *testing.T) {
+	converter := servicev2.NewConverter()
+
+	// Bytes fields with presence are unset when nil, so an empty, non-nil
+	// value is present.
+	tests := []struct {
+		Name  string
+		Photo *wrapperspb.BytesValue
+		Want  []byte
+	}{
+		{Name: "unset"},
+		{Name: "zero", Photo: wrapperspb.Bytes(nil), Want: []byte{}},
+		{Name: "value", Photo: wrapperspb.Bytes([]byte("jpeg")), Want: []byte("jpeg")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			priv, err := converter.ToPrivatePerson(&v2pb.Person{Photo: test.Photo})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if (priv.Photo == nil) != (test.Want == nil) || !bytes.Equal(priv.Photo, test.Want) {
+				t.Fatalf("expected private photo %#v, got %#v", test.Want, priv.Photo)
+			}
+
+			person, err := converter.ToPublicPerson(priv)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.Photo, person.Photo, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected public photo (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEnumPresence(t *testing.T) {
+	v1Converter := servicev1.NewConverter()
+	v2Converter := servicev2.NewConverter()
+
+	// Optional enums are converted when present, including their zero value.
+	// Enums without presence are only converted when not zero.
+	tests := map[string]struct {
+		V1      v1pb.Person_Employment
+		V2      *v2pb.Person_Employment
+		Private *privatepb.Person_Employment
+	}{
+		"unset": {},
+		"zero": {
+			V2:      v2pb.Person_UNSET.Enum(),
+			Private: privatepb.Person_UNDEFINED.Enum(),
+		},
+		"value": {
+			V1:      v1pb.Person_EMPLOYED,
+			V2:      v2pb.Person_FULL_TIME.Enum(),
+			Private: privatepb.Person_FULL_TIME.Enum(),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			priv, err := v2Converter.ToPrivatePerson(&v2pb.Person{
+				Id:                 "4b0b4e4d-8e1d-4b4a-9c53-6d0e0e3c9a5f",
+				FullName:           "Jane Doe",
+				Employment:         v2pb.Person_FULL_TIME,
+				PreviousEmployment: test.V2,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.Private, priv.PreviousEmployment); diff != "" {
+				t.Fatalf("unexpected private employment (-want +got):\n%s", diff)
+			}
+
+			person, err := v2Converter.ToPublicPerson(priv)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.V2, person.PreviousEmployment); diff != "" {
+				t.Fatalf("unexpected public employment (-want +got):\n%s", diff)
+			}
+
+			// The zero value of v1 is not present, so it converts to unset.
+			if name == "zero" {
+				return
+			}
+
+			next, err := v1Converter.ToNextPerson(&v1pb.Person{PreviousEmployment: test.V1})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.V2, next.PreviousEmployment); diff != "" {
+				t.Fatalf("unexpected next employment (-want +got):\n%s", diff)
+			}
+
+			// Deprecated v1 names are read from the private person.
+			priv.FirstName, priv.LastName = "Jane", "Doe"
+			v1Person, err := v1Converter.ToPublicPerson(person, priv)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if v1Person.PreviousEmployment != test.V1 {
+				t.Fatalf("expected v1 employment %s, got %s", test.V1, v1Person.PreviousEmployment)
+			}
+		})
+	}
+}
+
+func TestV1Conversion(t *testing.T) {
+	converter := servicev1.NewConverter()
+
+	tests := []struct {
+		Name    string
+		Public  string
+		Private int64
+	}{
+		{Name: "zero"},
+		{Name: "value", Public: "42", Private: 42},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			priv, err := converter.ToPrivatePerson(&v1pb.Person{Age: test.Public})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if priv.Age != test.Private {
+				t.Fatalf("expected private age %d, got %d", test.Private, priv.Age)
+			}
+
+			person, err := converter.ToDeprecatedPublicPerson(&privatepb.Person{
+				Id:         "4b0b4e4d-8e1d-4b4a-9c53-6d0e0e3c9a5f",
+				FirstName:  "Jane",
+				LastName:   "Doe",
+				Employment: privatepb.Person_FULL_TIME,
+				Age:        test.Private,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if person.Age != test.Public {
+				t.Fatalf("expected public age %q, got %q", test.Public, person.Age)
+			}
+		})
+	}
+
+	if _, err := converter.ToPrivatePerson(&v1pb.Person{Age: "forty-two"}); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestV1Compose(t *testing.T) {
+	converter := servicev1.NewConverter()
+
+	tests := []struct {
+		Name     string
+		In       *v1pb.CreateRequest
+		FullName string
+	}{
+		{Name: "unset", In: &v1pb.CreateRequest{}},
+		{Name: "first name", In: &v1pb.CreateRequest{FirstName: "Jane"}, FullName: "Jane"},
+		{Name: "last name", In: &v1pb.CreateRequest{LastName: "Doe"}, FullName: "Doe"},
+		{Name: "full name", In: &v1pb.CreateRequest{FirstName: "Jane", LastName: "Doe"}, FullName: "Jane Doe"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			next, err := converter.ToNextCreateRequest(test.In)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if next.FullName != test.FullName {
+				t.Fatalf("expected full name %q, got %q", test.FullName, next.FullName)
+			}
+		})
+	}
+}
+
+func TestV1ComposeFunc(t *testing.T) {
+	converter := servicev1.NewConverterWithFieldConverters(overridev1.FieldConverters())
+
+	tests := map[string]struct {
+		In    *v1pb.SearchRequest
+		Query string
+	}{
+		"unset": {
+			In: &v1pb.SearchRequest{},
+		},
+		"first name": {
+			In:    &v1pb.SearchRequest{FirstName: "Jane"},
+			Query: "Jane",
+		},
+		"full name": {
+			In:    &v1pb.SearchRequest{FirstName: "Jane", LastName: "Doe"},
+			Query: "Jane Doe",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			next, err := converter.ToNextSearchRequest(test.In)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if next.Query != test.Query {
+				t.Fatalf("expected query %q, got %q", test.Query, next.Query)
+			}
+
+			public, err := converter.ToPublicSearchRequest(next, &privatepb.SearchRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(test.In, public, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected split (-want +got):\n%s", diff)
+			}
+		})
+	}
+
+	if _, err := servicev1.NewConverter().ToNextSearchRequest(&v1pb.SearchRequest{}); err == nil {
+		t.Fatal("expected unregistered field converter error")
+	}
+}
+
+func TestV1FieldPath(t *testing.T) {
+	converter := servicev1.NewConverter()
+
+	next, err := converter.ToNextPerson(&v1pb.Person{City: "Berlin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if city := next.GetAddress().GetCity(); city != "Berlin" {
+		t.Fatalf("expected next city %q, got %q", "Berlin", city)
+	}
+
+	priv, err := converter.ToPrivatePerson(&v1pb.Person{City: "Berlin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if city := priv.GetAddress().GetCity(); city != "Berlin" {
+		t.Fatalf("expected private city %q, got %q", "Berlin", city)
+	}
+
+	person, err := converter.ToDeprecatedPublicPerson(&privatepb.Person{
+		Id:         "4b0b4e4d-8e1d-4b4a-9c53-6d0e0e3c9a5f",
+		FirstName:  "Jane",
+		LastName:   "Doe",
+		Employment: privatepb.Person_FULL_TIME,
+		Address:    &privatepb.Person_Address{City: "Berlin"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if person.City != "Berlin" {
+		t.Fatalf("expected public city %q, got %q", "Berlin", person.City)
+	}
+
+	next, err = converter.ToNextPerson(&v1pb.Person{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if next.Address != nil {
+		t.Fatalf("expected unset next address, got %v", next.Address)
+	}
+}
+
+func TestV1SharedPrivateMessage(t *testing.T) {
+	converter := servicev1.NewConverter()
+
+	priv, err := converter.ToPrivatePerson(&v1pb.Person{
+		Address: &v1pb.Address{Street: "Main St"},
+		Phone:   &v1pb.Phone{Number: "555-0100"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &privatepb.Contact{Street: "Main St", PhoneNumber: "555-0100"}
+	if !proto.Equal(priv.Contact, want) {
+		t.Fatalf("expected private contact %v, got %v", want, priv.Contact)
+	}
+
+	person, err := converter.ToDeprecatedPublicPerson(&privatepb.Person{
+		Id:         "4b0b4e4d-8e1d-4b4a-9c53-6d0e0e3c9a5f",
+		FirstName:  "Jane",
+		LastName:   "Doe",
+		Employment: privatepb.Person_FULL_TIME,
+		Contact:    want,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if street := person.GetAddress().GetStreet(); street != "Main St" {
+		t.Fatalf("expected public street %q, got %q", "Main St", street)
+	}
+
+	if number := person.GetPhone().GetNumber(); number != "555-0100" {
+		t.Fatalf("expected public phone number %q, got %q", "555-0100", number)
+	}
+
+	priv, err = converter.ToPrivatePerson(&v1pb.Person{Phone: &v1pb.Phone{Number: "555-0100"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if priv.GetContact().GetPhoneNumber() != "555-0100" || priv.GetContact().GetStreet() != "" {
+		t.Fatalf("expected private contact with phone number only, got %v", priv.Contact)
+	}
+}
+
+type upsertImpl struct {
+	privatepb.UnimplementedPeopleServer
+	calls  []string
+	people map[string]*privatepb.Person
+}
+
+func (u *upsertImpl) Fetch(ctx context.Context, in *privatepb.FetchRequest) (*privatepb.FetchResponse, error) {
+	u.calls = append(u.calls, "Fetch")
+	person, ok := u.people[in.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &privatepb.FetchResponse{Person: person}, nil
+}
+
+func (u *upsertImpl) Create(ctx context.Context, in *privatepb.CreateRequest) (*privatepb.CreateResponse, error) {
+	u.calls = append(u.calls, "Create")
+	person := &privatepb.Person{
+		Id:         in.Id,
+		FirstName:  in.FirstName,
+		LastName:   in.LastName,
+		FullName:   in.FullName,
+		Age:        in.Age,
+		Employment: in.Employment,
+		Hobby:      in.Hobby,
+	}
+	u.people[in.Id] = person
+
+	return &privatepb.CreateResponse{Person: person}, nil
+}
+
+func (u *upsertImpl) Update(ctx context.Context, in *privatepb.UpdateRequest) (*privatepb.UpdateResponse, error) {
+	u.calls = append(u.calls, "Update")
+	u.people[in.Id] = in.Person
+
+	return &privatepb.UpdateResponse{Person: in.Person}, nil
+}
+
+func TestV1Hook(t *testing.T) {
+	impl := &upsertImpl{people: make(map[string]*privatepb.Person)}
+	svcPrivate := &serviceprivate.Service{
+		Validator: serviceprivate.NewValidator(),
+		Impl:      impl,
+	}
+
+	svcV1 := &servicev1.Service{
+		Validator: servicev1.NewValidator(),
+		Converter: servicev1.NewConverter(),
+		Private:   svcPrivate,
+	}
+
+	id := "4b0b4e4d-8e1d-4b4a-9c53-6d0e0e3c9a5f"
+	in := &v1pb.UpsertRequest{
+		Id: id,
+		Person: &v1pb.Person{
+			Id:         id,
+			FirstName:  "Jane",
+			LastName:   "Doe",
+			Age:        "36",
+			Employment: v1pb.Person_EMPLOYED,
+			Hobby: &v1pb.Hobby{
+				Type: &v1pb.Hobby_Coding{Coding: &v1pb.Coding{Language: "go"}},
+			},
+		},
+	}
+
+	if _, err := svcV1.Upsert(context.Background(), in); status.Code(err) != codes.Unimplemented {
+		t.Fatalf("expected unimplemented error, got %v", err)
+	}
+
+	svcV1.UpsertHook = overridev1.UpsertHook{}
+
+	for i := 0; i < 2; i++ {
+		out, err := svcV1.Upsert(context.Background(), in)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if out.GetPerson().GetFirstName() != "Jane" || out.GetPerson().GetAge() != "36" {
+			t.Fatalf("unexpected person %v", out.GetPerson())
+		}
+	}
+
+	want := []string{"Fetch", "Create", "Fetch", "Update"}
+	if diff := cmp.Diff(want, impl.calls); diff != "" {
+		t.Fatalf("unexpected calls (-want +got):\n%s", diff)
+	}
+
+	if name := impl.people[id].GetFullName(); name != "Jane Doe" {
+		t.Fatalf("expected private full name %q, got %q", "Jane Doe", name)
+	}
+}
+
+type fetchImpl struct {
+	privatepb.UnimplementedPeopleServer
+	people map[string]*privatepb.Person
+}
+
+func (f fetchImpl) Fetch(ctx context.Context, in *privatepb.FetchRequest) (*privatepb.FetchResponse, error) {
+	person, ok := f.people[in.Id]
+	if !ok {
+		st, err := status.New(codes.NotFound, "not found").WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "id", Description: "not found"},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, st.Err()
+	}
+
+	return &privatepb.FetchResponse{Person: person}, nil
+}
+
+func TestV2Alias(t *testing.T) {
+	ids := []string{
+		"4b0b4e4d-8e1d-4b4a-9c53-6d0e0e3c9a5f",
+		"9d7c1f5e-3a2b-4c6d-8e9f-0a1b2c3d4e5f",
+	}
+
+	impl := fetchImpl{people: make(map[string]*privatepb.Person)}
+	for _, id := range ids {
+		impl.people[id] = &privatepb.Person{Id: id, FullName: "Jane Doe"}
+	}
+
+	svcV2 := &servicev2.Service{
+		Validator: servicev2.NewValidator(),
+		Converter: servicev2.NewConverter(),
+		Private: &serviceprivate.Service{
+			Validator: serviceprivate.NewValidator(),
+			Impl:      impl,
+		},
+	}
+
+	in := &v2pb.GetManyRequest{
+		Requests: []*v2pb.GetRequest{{Id: ids[1]}, {Id: ids[0]}},
+	}
+
+	out, err := svcV2.GetMany(context.Background(), in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(out.Responses) != 2 || out.Responses[0].GetPerson().GetId() != ids[1] || out.Responses[1].GetPerson().GetId() != ids[0] {
+		t.Fatalf("unexpected responses %v", out.Responses)
+	}
+
+	in.Requests = append(in.Requests, &v2pb.GetRequest{Id: "0e0c8a7b-6d5e-4f3a-2b1c-0d9e8f7a6b5c"})
+	_, err = svcV2.GetMany(context.Background(), in)
+
+	got := status.Convert(err)
+	if got.Code() != codes.NotFound {
+		t.Fatalf("unexpected code %s", got.Code())
+	}
+
+	want := []interface{}{
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: "requests[2].id", Description: "not found"},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(want, got.Details(), protocmp.Transform()); diff != "" {
+		t.Fatalf("unexpected details (-want +got):\n%s", diff)
+	}
+
+	in.Requests = []*v2pb.GetRequest{{Id: "invalid"}}
+	if _, err := svcV2.GetMany(context.Background(), in); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected invalid argument error, got %v", err)
+	}
+}
+
+// TestV1ValidateUnsetMessages checks that unset message fields are left to
+// their required rule. Validating a person without an address used to
+// dereference the nil address.
+func TestV1ValidateUnsetMessages(t *testing.T) {
+	person := &v1pb.Person{
+		Id:         "4b0b4e4d-8e1d-4b4a-9c53-6d0e0e3c9a5f",
+		FirstName:  "Jane",
+		LastName:   "Doe",
+		Age:        "36",
+		Employment: v1pb.Person_EMPLOYED,
+		Hobby: &v1pb.Hobby{
+			Type: &v1pb.Hobby_Coding{Coding: &v1pb.Coding{Language: "go"}},
+		},
+	}
+
+	tests := map[string]struct {
+		Validate func(servicev1.Validator) error
+		Err      bool
+	}{
+		"nil message": {
+			Validate: func(v servicev1.Validator) error {
+				return v.ByAddress((*v1pb.Address)(nil))
+			},
+		},
+		"unset optional message": {
+			Validate: func(v servicev1.Validator) error {
+				return v.ValidatePerson(person)
+			},
+		},
+		"unset required message": {
+			Validate: func(v servicev1.Validator) error {
+				return v.ValidateUpsertRequest(&v1pb.UpsertRequest{Id: person.Id})
+			},
+			Err: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if err := test.Validate(servicev1.NewValidator()); (err != nil) != test.Err {
+				t.Fatalf("expected error %t, got %v", test.Err, err)
+			}
+		})
+	}
+}
+
+// blockingFetchImpl fails to find unknown people, and blocks fetching known
+// people until the context is done.
+type blockingFetchImpl struct {
+	privatepb.UnimplementedPeopleServer
+	known map[string]bool
+	calls *int32
+}
+
+func (f blockingFetchImpl) Fetch(ctx context.Context, in *privatepb.FetchRequest) (*privatepb.FetchResponse, error) {
+	atomic.AddInt32(f.calls, 1)
+	if !f.known[in.Id] {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	<-ctx.Done()
+	return nil, status.FromContextError(ctx.Err()).Err()
+}
+
+func TestV2AliasCancel(t *testing.T) {
+	impl := blockingFetchImpl{known: make(map[string]bool), calls: new(int32)}
+	in := &v2pb.GetManyRequest{
+		Requests: []*v2pb.GetRequest{{Id: "0e0c8a7b-6d5e-4f3a-2b1c-0d9e8f7a6b5c"}},
+	}
+
+	for i := 0; i < 20; i++ {
+		id := fmt.Sprintf("4b0b4e4d-8e1d-4b4a-9c53-%012d", i)
+		impl.known[id] = true
+		in.Requests = append(in.Requests, &v2pb.GetRequest{Id: id})
+	}
+
+	svcV2 := &servicev2.Service{
+		Validator: servicev2.NewValidator(),
+		Converter: servicev2.NewConverter(),
+		Private: &serviceprivate.Service{
+			Validator: serviceprivate.NewValidator(),
+			Impl:      impl,
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := svcV2.GetMany(ctx, in)
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	// GetMany runs 4 calls at once, and stops calling once the first fails.
+	if calls := atomic.LoadInt32(impl.calls); calls > 4 {
+		t.Fatalf("expected at most 4 calls, got %d", calls)
+	}
+}
+
+// jsRunner calls the JavaScript converters of each call and writes the result,
+// or the error message, of each call as JSON.
+const jsRunner = `
+import { readFileSync } from "node:fs";
+import { pathToFileURL } from "node:url";
+
+const results = [];
+for (const call of JSON.parse(readFileSync(0, "utf8"))) {
+  const { newConverter } = await import(pathToFileURL(call.module).href);
+  try {
+    results.push({ value: newConverter()[call.func](...call.args) ?? null });
+  } catch (err) {
+    results.push({ error: err.message });
+  }
+}
+process.stdout.write(JSON.stringify(results));
+`
+
+type jsCall struct {
+	Module string            `json:"module"`
+	Func   string            `json:"func"`
+	Args   []json.RawMessage `json:"args"`
+}
+
+type jsResult struct {
+	Value json.RawMessage `json:"value"`
+	Error string          `json:"error"`
+}
+
+func TestJavaScriptConverters(t *testing.T) {
+	node, err := exec.LookPath("node")
+	if err != nil {
+		t.Skip("node is not installed")
+	}
+
+	converterV1 := servicev1.NewConverter()
+	converterV2 := servicev2.NewConverter()
+
+	person := &v1pb.Person{
+		Id:         "4b0b4e4d-8e1d-4b4a-9c53-6d0e0e3c9a5f",
+		FirstName:  "Jane",
+		LastName:   "Doe",
+		Employment: v1pb.Person_EMPLOYED,
+		CreatedAt:  timestamppb.New(time.Date(2021, 3, 4, 5, 6, 7, 8000000, time.UTC)),
+		Hobby: &v1pb.Hobby{
+			Type: &v1pb.Hobby_Biking{Biking: &v1pb.Biking{Style: "road"}},
+		},
+		Nickname: wrapperspb.String(""),
+		Age:      "42",
+		City:     "Berlin",
+		Address:  &v1pb.Address{Street: "Main St"},
+		Phone:    &v1pb.Phone{Number: "555-0100"},
+	}
+
+	privPerson, err := converterV1.ToPrivatePerson(person)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nextPerson, err := converterV1.ToNextPerson(person)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nextPerson.Employment = v2pb.Person_PART_TIME
+	privPerson.Employment = privatepb.Person_PART_TIME
+
+	create := &v1pb.CreateRequest{
+		Id:         person.Id,
+		FirstName:  "Jane",
+		LastName:   "Doe",
+		Employment: v1pb.Person_UNEMPLOYED,
+		Hobby: &v1pb.Hobby{
+			Type: &v1pb.Hobby_Coding{Coding: &v1pb.Coding{Language: "Go"}},
+		},
+	}
+
+	nextCreate, err := converterV1.ToNextCreateRequest(create)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	must := func(m proto.Message, err error) proto.Message {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+
+	tests := []struct {
+		Name   string
+		Module string
+		Func   string
+		Args   []proto.Message
+		Want   proto.Message
+		Error  string
+	}{
+		{
+			Name:   "v1 to next person",
+			Module: "v1",
+			Func:   "toNextPerson",
+			Args:   []proto.Message{person},
+			Want:   must(converterV1.ToNextPerson(person)),
+		},
+		{
+			Name:   "v1 to private person",
+			Module: "v1",
+			Func:   "toPrivatePerson",
+			Args:   []proto.Message{person},
+			Want:   must(converterV1.ToPrivatePerson(person)),
+		},
+		{
+			Name:   "v1 to public person",
+			Module: "v1",
+			Func:   "toPublicPerson",
+			Args:   []proto.Message{nextPerson, privPerson},
+			Want:   must(converterV1.ToPublicPerson(nextPerson, privPerson)),
+		},
+		{
+			Name:   "v1 to deprecated public person",
+			Module: "v1",
+			Func:   "toDeprecatedPublicPerson",
+			Args:   []proto.Message{privPerson},
+			Want:   must(converterV1.ToDeprecatedPublicPerson(privPerson)),
+		},
+		{
+			Name:   "v1 to next create request",
+			Module: "v1",
+			Func:   "toNextCreateRequest",
+			Args:   []proto.Message{create},
+			Want:   nextCreate,
+		},
+		{
+			Name:   "v2 to private create request",
+			Module: "v2",
+			Func:   "toPrivateCreateRequest",
+			Args:   []proto.Message{nextCreate},
+			Want:   must(converterV2.ToPrivateCreateRequest(nextCreate)),
+		},
+		{
+			Name:   "v2 to public person",
+			Module: "v2",
+			Func:   "toPublicPerson",
+			Args:   []proto.Message{privPerson},
+			Want:   must(converterV2.ToPublicPerson(privPerson)),
+		},
+		{
+			Name:   "v1 invalid age",
+			Module: "v1",
+			Func:   "toPrivatePerson",
+			Args:   []proto.Message{&v1pb.Person{Age: "forty-two"}},
+			Error:  `failed to convert field "age"`,
+		},
+		{
+			Name:   "v1 required fields",
+			Module: "v1",
+			Func:   "toDeprecatedPublicPerson",
+			Args:   []proto.Message{&privatepb.Person{}},
+			Error:  "employment: cannot be blank; firstName: cannot be blank; id: cannot be blank; lastName: cannot be blank.",
+		},
+	}
+
+	var calls []jsCall
+	for _, test := range tests {
+		module, err := filepath.Abs(filepath.Join("proto/go/service", test.Module, "converters.js"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		call := jsCall{Module: module, Func: test.Func}
+		for _, arg := range test.Args {
+			b, err := protojson.Marshal(arg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			call.Args = append(call.Args, b)
+		}
+
+		calls = append(calls, call)
+	}
+
+	in, err := json.Marshal(calls)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(node, "--input-type=module", "--eval", jsRunner)
+	cmd.Stdin = bytes.NewReader(in)
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("failed to run node: %v", err)
+	}
+
+	var results []jsResult
+	if err := json.Unmarshal(out, &results); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			result := results[i]
+			if test.Error != "" {
+				if !strings.Contains(result.Error, test.Error) {
+					t.Fatalf("expected error containing %q, got %q", test.Error, result.Error)
+				}
+				return
+			}
+
+			if result.Error != "" {
+				t.Fatalf("unexpected error %q", result.Error)
+			}
+
+			got := test.Want.ProtoReflect().Type().New().Interface()
+			if err := protojson.Unmarshal(result.Value, got); err != nil {
+				t.Fatalf("failed to unmarshal %s: %v", result.Value, err)
+			}
+
+			if diff := cmp.Diff(test.Want, got, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected conversion (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
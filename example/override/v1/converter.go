@@ -1,8 +1,6 @@
 package v1
 
 import (
-	"fmt"
-
 	public "github.com/dane/protoc-gen-go-svc/example/proto/go/service/v1"
 	publicpb "github.com/dane/protoc-gen-go-svc/example/proto/go/v1"
 	nextpb "github.com/dane/protoc-gen-go-svc/example/proto/go/v2"
@@ -12,9 +10,12 @@ type Converter struct {
 	public.Converter
 }
 
-func (c Converter) ToNextCreateRequest(req *publicpb.CreateRequest) *nextpb.CreateRequest {
-	nextReq := c.Converter.ToNextCreateRequest(req)
-	nextReq.FullName = fmt.Sprintf("%s %s", req.FirstName, req.LastName)
+func (c Converter) ToNextCreateRequest(req *publicpb.CreateRequest) (*nextpb.CreateRequest, error) {
+	nextReq, err := c.Converter.ToNextCreateRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
 	nextReq.Age = 36
-	return nextReq
+	return nextReq, nil
 }
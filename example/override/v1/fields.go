@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	public "github.com/dane/protoc-gen-go-svc/example/proto/go/service/v1"
+)
+
+// dateLayout is the layout of a date formatted as YYYY-MM-DD.
+const dateLayout = "2006-01-02"
+
+// FieldConverters returns the `Date` field conversions and the `Query`
+// composition of the v1 service. Empty dates convert to unset timestamps.
+func FieldConverters() public.FieldConverters {
+	return public.FieldConverters{
+		QueryCompose: func(firstName, lastName string) (string, error) {
+			return strings.TrimSpace(firstName + " " + lastName), nil
+		},
+		QuerySplit: func(query string) (string, string, error) {
+			parts := strings.SplitN(query, " ", 2)
+			if len(parts) == 1 {
+				return parts[0], "", nil
+			}
+
+			return parts[0], parts[1], nil
+		},
+		DateToPrivate: func(in string) (*timestamppb.Timestamp, error) {
+			if in == "" {
+				return nil, nil
+			}
+
+			t, err := time.Parse(dateLayout, in)
+			if err != nil {
+				return nil, err
+			}
+
+			return timestamppb.New(t), nil
+		},
+		DateFromPrivate: func(in *timestamppb.Timestamp) (string, error) {
+			if in == nil {
+				return "", nil
+			}
+
+			return in.AsTime().Format(dateLayout), nil
+		},
+	}
+}
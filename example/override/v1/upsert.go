@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	privatepb "github.com/dane/protoc-gen-go-svc/example/proto/go/private"
+	private "github.com/dane/protoc-gen-go-svc/example/proto/go/service/private"
+	public "github.com/dane/protoc-gen-go-svc/example/proto/go/service/v1"
+)
+
+// UpsertHook updates a person through the private service, creating the person
+// when it is not found.
+type UpsertHook struct{}
+
+func (h UpsertHook) Name() string {
+	return public.UpsertHookName
+}
+
+func (h UpsertHook) Upsert(ctx context.Context, in *privatepb.UpdateRequest, priv *private.Service) (*privatepb.UpdateResponse, error) {
+	person := in.GetPerson()
+	if person.GetFullName() == "" {
+		person.FullName = strings.TrimSpace(person.GetFirstName() + " " + person.GetLastName())
+	}
+
+	_, err := priv.Fetch(ctx, &privatepb.FetchRequest{Id: in.Id})
+	if status.Code(err) != codes.NotFound {
+		if err != nil {
+			return nil, err
+		}
+
+		return priv.Update(ctx, in)
+	}
+
+	out, err := priv.Create(ctx, &privatepb.CreateRequest{
+		Id:         in.Id,
+		FirstName:  person.GetFirstName(),
+		LastName:   person.GetLastName(),
+		FullName:   person.GetFullName(),
+		Age:        person.GetAge(),
+		Employment: person.GetEmployment(),
+		Hobby:      person.GetHobby(),
+		Nickname:   person.Nickname,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &privatepb.UpdateResponse{Person: out.Person}, nil
+}
@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-go-svc. DO NOT EDIT.
+// source: example.private
+
+export interface Person {
+  id?: string;
+  firstName?: string;
+  lastName?: string;
+  fullName?: string;
+  age?: string;
+  employment?: "UNDEFINED" | "FULL_TIME" | "PART_TIME" | "UNEMPLOYED";
+  createdAt?: string;
+  updatedAt?: string;
+  deletedAt?: string;
+  hobby?: Hobby;
+  nickname?: string;
+  address?: Person_Address;
+  contact?: Contact;
+  photo?: string;
+  previousEmployment?: "UNDEFINED" | "FULL_TIME" | "PART_TIME" | "UNEMPLOYED";
+}
+
+export interface Person_Address {
+  city?: string;
+}
+
+export interface Contact {
+  street?: string;
+  phoneNumber?: string;
+}
+
+export interface Hobby {
+  coding?: Coding;
+  reading?: Reading;
+  cycling?: Cycling;
+}
+
+export interface Coding {
+  language?: string;
+}
+
+export interface Reading {
+  genre?: string;
+}
+
+export interface Cycling {
+  style?: string;
+}
+
+export interface CreateRequest {
+  id?: string;
+  firstName?: string;
+  lastName?: string;
+  fullName?: string;
+  age?: string;
+  employment?: "UNDEFINED" | "FULL_TIME" | "PART_TIME" | "UNEMPLOYED";
+  hobby?: Hobby;
+  nickname?: string;
+}
+
+export interface CreateResponse {
+  person?: Person;
+}
+
+export interface FetchRequest {
+  id?: string;
+}
+
+export interface FetchResponse {
+  person?: Person;
+}
+
+export interface DeleteRequest {
+  id?: string;
+}
+
+export interface DeleteResponse {
+  person?: Person;
+}
+
+export interface ListRequest {
+  pageSize?: number;
+  pageToken?: string;
+  createdAfter?: string;
+}
+
+export interface ListResponse {
+  people?: Array<Person>;
+  nextPageToken?: string;
+}
+
+export interface SearchRequest {
+  offset?: number;
+  limit?: number;
+  query?: string;
+  firstName?: string;
+  lastName?: string;
+}
+
+export interface SearchResponse {
+  people?: Array<Person>;
+}
+
+export interface UpdateRequest {
+  id?: string;
+  person?: Person;
+}
+
+export interface UpdateResponse {
+  person?: Person;
+}
+
+export interface BatchRequest {
+  creates?: Array<CreateRequest>;
+}
+
+export interface BatchResponse {
+  people?: Array<Person>;
+}
+
+export interface PingRequest {
+}
+
+export interface PingResponse {
+}
@@ -5,24 +5,48 @@ package private
 
 import (
 	context "context"
+	base64 "encoding/base64"
 	errors "errors"
+	fmt "fmt"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+	time "time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	is "github.com/go-ozzo/ozzo-validation/v4/is"
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	exttimestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 
 	privatepb "github.com/dane/protoc-gen-go-svc/example/proto/go/private"
 )
 
 var (
 	_ = errors.New
+	_ = fmt.Errorf
+	_ = time.Parse
 	_ = context.Background
 	_ = validation.Validate
 	_ = is.Int
 	_ = codes.OK
 	_ = status.Errorf
+	_ = proto.Merge
+	_ = strings.IndexByte
+	_ = (*sync.WaitGroup)(nil)
+	_ = base64.RawURLEncoding
+	_ = strconv.FormatInt
+	_ = (*errdetails.BadRequest)(nil)
+	_ = anypb.New
+	_ = durationpb.New
+	_ = timestamppb.New
+	_ = wrapperspb.String
 	_ = privatepb.RegisterPeopleServer
 )
 
@@ -36,82 +60,175 @@ type Service struct {
 	Impl privatepb.PeopleServer
 }
 
+// CreateRequestMutator sets fields of example.private.CreateRequest before it is passed to the Create method.
 type CreateRequestMutator func(*privatepb.CreateRequest)
 
+// SetCreateRequest_Id returns a mutator setting example.private.CreateRequest.id.
 func SetCreateRequest_Id(value string) CreateRequestMutator {
 	return func(in *privatepb.CreateRequest) {
 		in.Id = value
 	}
 }
+
+// SetCreateRequest_FirstName returns a mutator setting example.private.CreateRequest.first_name.
 func SetCreateRequest_FirstName(value string) CreateRequestMutator {
 	return func(in *privatepb.CreateRequest) {
 		in.FirstName = value
 	}
 }
+
+// SetCreateRequest_LastName returns a mutator setting example.private.CreateRequest.last_name.
 func SetCreateRequest_LastName(value string) CreateRequestMutator {
 	return func(in *privatepb.CreateRequest) {
 		in.LastName = value
 	}
 }
+
+// SetCreateRequest_FullName returns a mutator setting example.private.CreateRequest.full_name.
 func SetCreateRequest_FullName(value string) CreateRequestMutator {
 	return func(in *privatepb.CreateRequest) {
 		in.FullName = value
 	}
 }
+
+// SetCreateRequest_Age returns a mutator setting example.private.CreateRequest.age.
 func SetCreateRequest_Age(value int64) CreateRequestMutator {
 	return func(in *privatepb.CreateRequest) {
 		in.Age = value
 	}
 }
+
+// SetCreateRequest_Employment returns a mutator setting example.private.CreateRequest.employment.
 func SetCreateRequest_Employment(value privatepb.Person_Employment) CreateRequestMutator {
 	return func(in *privatepb.CreateRequest) {
 		in.Employment = value
 	}
 }
+
+// SetCreateRequest_Hobby returns a mutator setting example.private.CreateRequest.hobby.
 func SetCreateRequest_Hobby(value *privatepb.Hobby) CreateRequestMutator {
 	return func(in *privatepb.CreateRequest) {
 		in.Hobby = value
 	}
 }
 
+// SetCreateRequest_Nickname returns a mutator setting example.private.CreateRequest.nickname.
+func SetCreateRequest_Nickname(value *string) CreateRequestMutator {
+	return func(in *privatepb.CreateRequest) {
+		in.Nickname = value
+	}
+}
+
+// FetchRequestMutator sets fields of example.private.FetchRequest before it is passed to the Fetch method.
 type FetchRequestMutator func(*privatepb.FetchRequest)
 
+// SetFetchRequest_Id returns a mutator setting example.private.FetchRequest.id.
 func SetFetchRequest_Id(value string) FetchRequestMutator {
 	return func(in *privatepb.FetchRequest) {
 		in.Id = value
 	}
 }
 
+// DeleteRequestMutator sets fields of example.private.DeleteRequest before it is passed to the Delete method.
 type DeleteRequestMutator func(*privatepb.DeleteRequest)
 
+// SetDeleteRequest_Id returns a mutator setting example.private.DeleteRequest.id.
 func SetDeleteRequest_Id(value string) DeleteRequestMutator {
 	return func(in *privatepb.DeleteRequest) {
 		in.Id = value
 	}
 }
 
+// ListRequestMutator sets fields of example.private.ListRequest before it is passed to the List method.
 type ListRequestMutator func(*privatepb.ListRequest)
+
+// SetListRequest_PageSize returns a mutator setting example.private.ListRequest.page_size.
+func SetListRequest_PageSize(value int32) ListRequestMutator {
+	return func(in *privatepb.ListRequest) {
+		in.PageSize = value
+	}
+}
+
+// SetListRequest_PageToken returns a mutator setting example.private.ListRequest.page_token.
+func SetListRequest_PageToken(value string) ListRequestMutator {
+	return func(in *privatepb.ListRequest) {
+		in.PageToken = value
+	}
+}
+
+// SetListRequest_CreatedAfter returns a mutator setting example.private.ListRequest.created_after.
+func SetListRequest_CreatedAfter(value *exttimestamppb.Timestamp) ListRequestMutator {
+	return func(in *privatepb.ListRequest) {
+		in.CreatedAfter = value
+	}
+}
+
+// SearchRequestMutator sets fields of example.private.SearchRequest before it is passed to the Search method.
+type SearchRequestMutator func(*privatepb.SearchRequest)
+
+// SetSearchRequest_Offset returns a mutator setting example.private.SearchRequest.offset.
+func SetSearchRequest_Offset(value int32) SearchRequestMutator {
+	return func(in *privatepb.SearchRequest) {
+		in.Offset = value
+	}
+}
+
+// SetSearchRequest_Limit returns a mutator setting example.private.SearchRequest.limit.
+func SetSearchRequest_Limit(value int32) SearchRequestMutator {
+	return func(in *privatepb.SearchRequest) {
+		in.Limit = value
+	}
+}
+
+// SetSearchRequest_Query returns a mutator setting example.private.SearchRequest.query.
+func SetSearchRequest_Query(value string) SearchRequestMutator {
+	return func(in *privatepb.SearchRequest) {
+		in.Query = value
+	}
+}
+
+// SetSearchRequest_FirstName returns a mutator setting example.private.SearchRequest.first_name.
+func SetSearchRequest_FirstName(value string) SearchRequestMutator {
+	return func(in *privatepb.SearchRequest) {
+		in.FirstName = value
+	}
+}
+
+// SetSearchRequest_LastName returns a mutator setting example.private.SearchRequest.last_name.
+func SetSearchRequest_LastName(value string) SearchRequestMutator {
+	return func(in *privatepb.SearchRequest) {
+		in.LastName = value
+	}
+}
+
+// UpdateRequestMutator sets fields of example.private.UpdateRequest before it is passed to the Update method.
 type UpdateRequestMutator func(*privatepb.UpdateRequest)
 
+// SetUpdateRequest_Id returns a mutator setting example.private.UpdateRequest.id.
 func SetUpdateRequest_Id(value string) UpdateRequestMutator {
 	return func(in *privatepb.UpdateRequest) {
 		in.Id = value
 	}
 }
+
+// SetUpdateRequest_Person returns a mutator setting example.private.UpdateRequest.person.
 func SetUpdateRequest_Person(value *privatepb.Person) UpdateRequestMutator {
 	return func(in *privatepb.UpdateRequest) {
 		in.Person = value
 	}
 }
 
+// BatchRequestMutator sets fields of example.private.BatchRequest before it is passed to the Batch method.
 type BatchRequestMutator func(*privatepb.BatchRequest)
 
+// SetBatchRequest_Creates returns a mutator setting example.private.BatchRequest.creates.
 func SetBatchRequest_Creates(value []*privatepb.CreateRequest) BatchRequestMutator {
 	return func(in *privatepb.BatchRequest) {
 		in.Creates = value
 	}
 }
 
+// PingRequestMutator sets fields of example.private.PingRequest before it is passed to the Ping method.
 type PingRequestMutator func(*privatepb.PingRequest)
 
 func NewValidator() Validator {
@@ -122,6 +239,10 @@ type Validator interface {
 	Name() string
 	ValidatePerson(*privatepb.Person) error
 	ByPerson(interface{}) error
+	ValidatePerson_Address(*privatepb.Person_Address) error
+	ByPerson_Address(interface{}) error
+	ValidateContact(*privatepb.Contact) error
+	ByContact(interface{}) error
 	ValidateHobby(*privatepb.Hobby) error
 	ByHobby(interface{}) error
 	ValidateCoding(*privatepb.Coding) error
@@ -146,6 +267,10 @@ type Validator interface {
 	ByListRequest(interface{}) error
 	ValidateListResponse(*privatepb.ListResponse) error
 	ByListResponse(interface{}) error
+	ValidateSearchRequest(*privatepb.SearchRequest) error
+	BySearchRequest(interface{}) error
+	ValidateSearchResponse(*privatepb.SearchResponse) error
+	BySearchResponse(interface{}) error
 	ValidateUpdateRequest(*privatepb.UpdateRequest) error
 	ByUpdateRequest(interface{}) error
 	ValidateUpdateResponse(*privatepb.UpdateResponse) error
@@ -168,6 +293,7 @@ func (v validator) Name() string {
 	return ValidatorName
 }
 
+// ValidatePerson validates example.private.Person.
 func (v validator) ValidatePerson(in *privatepb.Person) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id,
@@ -202,9 +328,19 @@ func (v validator) ValidatePerson(in *privatepb.Person) error {
 			validation.Required,
 			validation.By(v.ByHobby),
 		),
+		validation.Field(&in.Nickname),
+		validation.Field(&in.Address,
+			validation.By(v.ByPerson_Address),
+		),
+		validation.Field(&in.Contact,
+			validation.By(v.ByContact),
+		),
+		validation.Field(&in.Photo),
+		validation.Field(&in.PreviousEmployment),
 	)
 }
 
+// ByPerson validates example.private.Person as an ozzo-validation rule.
 func (v validator) ByPerson(value interface{}) error {
 	var in *privatepb.Person
 	if v, ok := value.(*privatepb.Person); ok {
@@ -214,8 +350,69 @@ func (v validator) ByPerson(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidatePerson(in)
 }
+
+// ValidatePerson_Address validates example.private.Person.Address.
+func (v validator) ValidatePerson_Address(in *privatepb.Person_Address) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.City),
+	)
+}
+
+// ByPerson_Address validates example.private.Person.Address as an ozzo-validation rule.
+func (v validator) ByPerson_Address(value interface{}) error {
+	var in *privatepb.Person_Address
+	if v, ok := value.(*privatepb.Person_Address); ok {
+		in = v
+	} else {
+		v := value.(privatepb.Person_Address)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidatePerson_Address(in)
+}
+
+// ValidateContact validates example.private.Contact.
+func (v validator) ValidateContact(in *privatepb.Contact) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.Street),
+		validation.Field(&in.PhoneNumber),
+	)
+}
+
+// ByContact validates example.private.Contact as an ozzo-validation rule.
+func (v validator) ByContact(value interface{}) error {
+	var in *privatepb.Contact
+	if v, ok := value.(*privatepb.Contact); ok {
+		in = v
+	} else {
+		v := value.(privatepb.Contact)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateContact(in)
+}
+
+// ValidateHobby validates example.private.Hobby.
 func (v validator) ValidateHobby(in *privatepb.Hobby) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Type,
@@ -224,6 +421,7 @@ func (v validator) ValidateHobby(in *privatepb.Hobby) error {
 	)
 }
 
+// ByHobby validates example.private.Hobby as an ozzo-validation rule.
 func (v validator) ByHobby(value interface{}) error {
 	var in *privatepb.Hobby
 	if v, ok := value.(*privatepb.Hobby); ok {
@@ -233,14 +431,23 @@ func (v validator) ByHobby(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateHobby(in)
 }
+
+// ValidateCoding validates example.private.Coding.
 func (v validator) ValidateCoding(in *privatepb.Coding) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Language),
 	)
 }
 
+// ByCoding validates example.private.Coding as an ozzo-validation rule.
 func (v validator) ByCoding(value interface{}) error {
 	var in *privatepb.Coding
 	if v, ok := value.(*privatepb.Coding); ok {
@@ -250,14 +457,23 @@ func (v validator) ByCoding(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateCoding(in)
 }
+
+// ValidateReading validates example.private.Reading.
 func (v validator) ValidateReading(in *privatepb.Reading) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Genre),
 	)
 }
 
+// ByReading validates example.private.Reading as an ozzo-validation rule.
 func (v validator) ByReading(value interface{}) error {
 	var in *privatepb.Reading
 	if v, ok := value.(*privatepb.Reading); ok {
@@ -267,14 +483,23 @@ func (v validator) ByReading(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateReading(in)
 }
+
+// ValidateCycling validates example.private.Cycling.
 func (v validator) ValidateCycling(in *privatepb.Cycling) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Style),
 	)
 }
 
+// ByCycling validates example.private.Cycling as an ozzo-validation rule.
 func (v validator) ByCycling(value interface{}) error {
 	var in *privatepb.Cycling
 	if v, ok := value.(*privatepb.Cycling); ok {
@@ -284,8 +509,16 @@ func (v validator) ByCycling(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateCycling(in)
 }
+
+// ValidateCreateRequest validates example.private.CreateRequest.
 func (v validator) ValidateCreateRequest(in *privatepb.CreateRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id,
@@ -314,9 +547,11 @@ func (v validator) ValidateCreateRequest(in *privatepb.CreateRequest) error {
 			validation.Required,
 			validation.By(v.ByHobby),
 		),
+		validation.Field(&in.Nickname),
 	)
 }
 
+// ByCreateRequest validates example.private.CreateRequest as an ozzo-validation rule.
 func (v validator) ByCreateRequest(value interface{}) error {
 	var in *privatepb.CreateRequest
 	if v, ok := value.(*privatepb.CreateRequest); ok {
@@ -326,8 +561,16 @@ func (v validator) ByCreateRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateCreateRequest(in)
 }
+
+// ValidateCreateResponse validates example.private.CreateResponse.
 func (v validator) ValidateCreateResponse(in *privatepb.CreateResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Person,
@@ -336,6 +579,7 @@ func (v validator) ValidateCreateResponse(in *privatepb.CreateResponse) error {
 	)
 }
 
+// ByCreateResponse validates example.private.CreateResponse as an ozzo-validation rule.
 func (v validator) ByCreateResponse(value interface{}) error {
 	var in *privatepb.CreateResponse
 	if v, ok := value.(*privatepb.CreateResponse); ok {
@@ -345,14 +589,23 @@ func (v validator) ByCreateResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateCreateResponse(in)
 }
+
+// ValidateFetchRequest validates example.private.FetchRequest.
 func (v validator) ValidateFetchRequest(in *privatepb.FetchRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id),
 	)
 }
 
+// ByFetchRequest validates example.private.FetchRequest as an ozzo-validation rule.
 func (v validator) ByFetchRequest(value interface{}) error {
 	var in *privatepb.FetchRequest
 	if v, ok := value.(*privatepb.FetchRequest); ok {
@@ -362,8 +615,16 @@ func (v validator) ByFetchRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateFetchRequest(in)
 }
+
+// ValidateFetchResponse validates example.private.FetchResponse.
 func (v validator) ValidateFetchResponse(in *privatepb.FetchResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Person,
@@ -372,6 +633,7 @@ func (v validator) ValidateFetchResponse(in *privatepb.FetchResponse) error {
 	)
 }
 
+// ByFetchResponse validates example.private.FetchResponse as an ozzo-validation rule.
 func (v validator) ByFetchResponse(value interface{}) error {
 	var in *privatepb.FetchResponse
 	if v, ok := value.(*privatepb.FetchResponse); ok {
@@ -381,14 +643,23 @@ func (v validator) ByFetchResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateFetchResponse(in)
 }
+
+// ValidateDeleteRequest validates example.private.DeleteRequest.
 func (v validator) ValidateDeleteRequest(in *privatepb.DeleteRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id),
 	)
 }
 
+// ByDeleteRequest validates example.private.DeleteRequest as an ozzo-validation rule.
 func (v validator) ByDeleteRequest(value interface{}) error {
 	var in *privatepb.DeleteRequest
 	if v, ok := value.(*privatepb.DeleteRequest); ok {
@@ -398,8 +669,16 @@ func (v validator) ByDeleteRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateDeleteRequest(in)
 }
+
+// ValidateDeleteResponse validates example.private.DeleteResponse.
 func (v validator) ValidateDeleteResponse(in *privatepb.DeleteResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Person,
@@ -408,6 +687,7 @@ func (v validator) ValidateDeleteResponse(in *privatepb.DeleteResponse) error {
 	)
 }
 
+// ByDeleteResponse validates example.private.DeleteResponse as an ozzo-validation rule.
 func (v validator) ByDeleteResponse(value interface{}) error {
 	var in *privatepb.DeleteResponse
 	if v, ok := value.(*privatepb.DeleteResponse); ok {
@@ -417,12 +697,29 @@ func (v validator) ByDeleteResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateDeleteResponse(in)
 }
+
+// ValidateListRequest validates example.private.ListRequest.
 func (v validator) ValidateListRequest(in *privatepb.ListRequest) error {
-	return validation.ValidateStruct(in)
+	return validation.ValidateStruct(in,
+		validation.Field(&in.PageSize,
+			validation.Max(100),
+		),
+		validation.Field(&in.PageToken),
+		validation.Field(&in.CreatedAfter,
+			validation.By(v.ByExternalTimestamp),
+		),
+	)
 }
 
+// ByListRequest validates example.private.ListRequest as an ozzo-validation rule.
 func (v validator) ByListRequest(value interface{}) error {
 	var in *privatepb.ListRequest
 	if v, ok := value.(*privatepb.ListRequest); ok {
@@ -432,16 +729,26 @@ func (v validator) ByListRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateListRequest(in)
 }
+
+// ValidateListResponse validates example.private.ListResponse.
 func (v validator) ValidateListResponse(in *privatepb.ListResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.People,
 			validation.Each(validation.By(v.ByPerson)),
 		),
+		validation.Field(&in.NextPageToken),
 	)
 }
 
+// ByListResponse validates example.private.ListResponse as an ozzo-validation rule.
 func (v validator) ByListResponse(value interface{}) error {
 	var in *privatepb.ListResponse
 	if v, ok := value.(*privatepb.ListResponse); ok {
@@ -451,8 +758,76 @@ func (v validator) ByListResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateListResponse(in)
 }
+
+// ValidateSearchRequest validates example.private.SearchRequest.
+func (v validator) ValidateSearchRequest(in *privatepb.SearchRequest) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.Offset),
+		validation.Field(&in.Limit,
+			validation.Max(100),
+		),
+		validation.Field(&in.Query),
+		validation.Field(&in.FirstName),
+		validation.Field(&in.LastName),
+	)
+}
+
+// BySearchRequest validates example.private.SearchRequest as an ozzo-validation rule.
+func (v validator) BySearchRequest(value interface{}) error {
+	var in *privatepb.SearchRequest
+	if v, ok := value.(*privatepb.SearchRequest); ok {
+		in = v
+	} else {
+		v := value.(privatepb.SearchRequest)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateSearchRequest(in)
+}
+
+// ValidateSearchResponse validates example.private.SearchResponse.
+func (v validator) ValidateSearchResponse(in *privatepb.SearchResponse) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.People,
+			validation.Each(validation.By(v.ByPerson)),
+		),
+	)
+}
+
+// BySearchResponse validates example.private.SearchResponse as an ozzo-validation rule.
+func (v validator) BySearchResponse(value interface{}) error {
+	var in *privatepb.SearchResponse
+	if v, ok := value.(*privatepb.SearchResponse); ok {
+		in = v
+	} else {
+		v := value.(privatepb.SearchResponse)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateSearchResponse(in)
+}
+
+// ValidateUpdateRequest validates example.private.UpdateRequest.
 func (v validator) ValidateUpdateRequest(in *privatepb.UpdateRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id,
@@ -466,6 +841,7 @@ func (v validator) ValidateUpdateRequest(in *privatepb.UpdateRequest) error {
 	)
 }
 
+// ByUpdateRequest validates example.private.UpdateRequest as an ozzo-validation rule.
 func (v validator) ByUpdateRequest(value interface{}) error {
 	var in *privatepb.UpdateRequest
 	if v, ok := value.(*privatepb.UpdateRequest); ok {
@@ -475,8 +851,16 @@ func (v validator) ByUpdateRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateUpdateRequest(in)
 }
+
+// ValidateUpdateResponse validates example.private.UpdateResponse.
 func (v validator) ValidateUpdateResponse(in *privatepb.UpdateResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Person,
@@ -485,6 +869,7 @@ func (v validator) ValidateUpdateResponse(in *privatepb.UpdateResponse) error {
 	)
 }
 
+// ByUpdateResponse validates example.private.UpdateResponse as an ozzo-validation rule.
 func (v validator) ByUpdateResponse(value interface{}) error {
 	var in *privatepb.UpdateResponse
 	if v, ok := value.(*privatepb.UpdateResponse); ok {
@@ -494,8 +879,16 @@ func (v validator) ByUpdateResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateUpdateResponse(in)
 }
+
+// ValidateBatchRequest validates example.private.BatchRequest.
 func (v validator) ValidateBatchRequest(in *privatepb.BatchRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Creates,
@@ -504,6 +897,7 @@ func (v validator) ValidateBatchRequest(in *privatepb.BatchRequest) error {
 	)
 }
 
+// ByBatchRequest validates example.private.BatchRequest as an ozzo-validation rule.
 func (v validator) ByBatchRequest(value interface{}) error {
 	var in *privatepb.BatchRequest
 	if v, ok := value.(*privatepb.BatchRequest); ok {
@@ -513,8 +907,16 @@ func (v validator) ByBatchRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateBatchRequest(in)
 }
+
+// ValidateBatchResponse validates example.private.BatchResponse.
 func (v validator) ValidateBatchResponse(in *privatepb.BatchResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.People,
@@ -523,6 +925,7 @@ func (v validator) ValidateBatchResponse(in *privatepb.BatchResponse) error {
 	)
 }
 
+// ByBatchResponse validates example.private.BatchResponse as an ozzo-validation rule.
 func (v validator) ByBatchResponse(value interface{}) error {
 	var in *privatepb.BatchResponse
 	if v, ok := value.(*privatepb.BatchResponse); ok {
@@ -532,12 +935,21 @@ func (v validator) ByBatchResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateBatchResponse(in)
 }
+
+// ValidatePingRequest validates example.private.PingRequest.
 func (v validator) ValidatePingRequest(in *privatepb.PingRequest) error {
 	return validation.ValidateStruct(in)
 }
 
+// ByPingRequest validates example.private.PingRequest as an ozzo-validation rule.
 func (v validator) ByPingRequest(value interface{}) error {
 	var in *privatepb.PingRequest
 	if v, ok := value.(*privatepb.PingRequest); ok {
@@ -547,12 +959,21 @@ func (v validator) ByPingRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidatePingRequest(in)
 }
+
+// ValidatePingResponse validates example.private.PingResponse.
 func (v validator) ValidatePingResponse(in *privatepb.PingResponse) error {
 	return validation.ValidateStruct(in)
 }
 
+// ByPingResponse validates example.private.PingResponse as an ozzo-validation rule.
 func (v validator) ByPingResponse(value interface{}) error {
 	var in *privatepb.PingResponse
 	if v, ok := value.(*privatepb.PingResponse); ok {
@@ -562,12 +983,21 @@ func (v validator) ByPingResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidatePingResponse(in)
 }
+
+// ValidateExternalTimestamp validates google.protobuf.Timestamp.
 func (v validator) ValidateExternalTimestamp(in *exttimestamppb.Timestamp) error {
 	return nil
 }
 
+// ByExternalTimestamp validates google.protobuf.Timestamp as an ozzo-validation rule.
 func (v validator) ByExternalTimestamp(value interface{}) error {
 	var in *exttimestamppb.Timestamp
 	if v, ok := value.(*exttimestamppb.Timestamp); ok {
@@ -577,9 +1007,16 @@ func (v validator) ByExternalTimestamp(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateExternalTimestamp(in)
 }
 
+// Create implements example.private.People.Create.
 func (s *Service) Create(ctx context.Context, in *privatepb.CreateRequest) (*privatepb.CreateResponse, error) {
 	if err := s.ValidateCreateRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -588,6 +1025,8 @@ func (s *Service) Create(ctx context.Context, in *privatepb.CreateRequest) (*pri
 	out, err := s.Impl.Create(ctx, in)
 	return out, err
 }
+
+// Fetch implements example.private.People.Fetch.
 func (s *Service) Fetch(ctx context.Context, in *privatepb.FetchRequest) (*privatepb.FetchResponse, error) {
 	if err := s.ValidateFetchRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -596,6 +1035,8 @@ func (s *Service) Fetch(ctx context.Context, in *privatepb.FetchRequest) (*priva
 	out, err := s.Impl.Fetch(ctx, in)
 	return out, err
 }
+
+// Delete implements example.private.People.Delete.
 func (s *Service) Delete(ctx context.Context, in *privatepb.DeleteRequest) (*privatepb.DeleteResponse, error) {
 	if err := s.ValidateDeleteRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -604,6 +1045,8 @@ func (s *Service) Delete(ctx context.Context, in *privatepb.DeleteRequest) (*pri
 	out, err := s.Impl.Delete(ctx, in)
 	return out, err
 }
+
+// List implements example.private.People.List.
 func (s *Service) List(ctx context.Context, in *privatepb.ListRequest) (*privatepb.ListResponse, error) {
 	if err := s.ValidateListRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -612,6 +1055,18 @@ func (s *Service) List(ctx context.Context, in *privatepb.ListRequest) (*private
 	out, err := s.Impl.List(ctx, in)
 	return out, err
 }
+
+// Search implements example.private.People.Search.
+func (s *Service) Search(ctx context.Context, in *privatepb.SearchRequest) (*privatepb.SearchResponse, error) {
+	if err := s.ValidateSearchRequest(in); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	out, err := s.Impl.Search(ctx, in)
+	return out, err
+}
+
+// Update implements example.private.People.Update.
 func (s *Service) Update(ctx context.Context, in *privatepb.UpdateRequest) (*privatepb.UpdateResponse, error) {
 	if err := s.ValidateUpdateRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -620,6 +1075,8 @@ func (s *Service) Update(ctx context.Context, in *privatepb.UpdateRequest) (*pri
 	out, err := s.Impl.Update(ctx, in)
 	return out, err
 }
+
+// Batch implements example.private.People.Batch.
 func (s *Service) Batch(ctx context.Context, in *privatepb.BatchRequest) (*privatepb.BatchResponse, error) {
 	if err := s.ValidateBatchRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -628,6 +1085,8 @@ func (s *Service) Batch(ctx context.Context, in *privatepb.BatchRequest) (*priva
 	out, err := s.Impl.Batch(ctx, in)
 	return out, err
 }
+
+// Ping implements example.private.People.Ping.
 func (s *Service) Ping(ctx context.Context, in *privatepb.PingRequest) (*privatepb.PingResponse, error) {
 	if err := s.ValidatePingRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -18,27 +18,30 @@ type Option interface {
 	Name() string
 }
 
-func RegisterServer(server *grpc.Server, impl privatepb.PeopleServer, options ...Option) {
+func RegisterServer(server grpc.ServiceRegistrar, impl privatepb.PeopleServer, options ...Option) {
 	servicePrivate := &privatesvc.Service{
 		Validator: privatesvc.NewValidator(),
 		Impl:      impl,
 	}
 
 	servicev2 := &v2svc.Service{
-		Validator: v2svc.NewValidator(),
-		Converter: v2svc.NewConverter(),
-		Private:   servicePrivate,
+		Validator:      v2svc.NewValidator(),
+		Converter:      v2svc.NewConverter(),
+		Private:        servicePrivate,
+		PageTokenCodec: v2svc.NewPageTokenCodec(),
 	}
 
 	v2pb.RegisterPeopleServer(server, servicev2)
 	servicev1 := &v1svc.Service{
-		Validator: v1svc.NewValidator(),
-		Converter: v1svc.NewConverter(),
-		Private:   servicePrivate,
-		Next:      servicev2,
+		Validator:      v1svc.NewValidator(),
+		Converter:      v1svc.NewConverter(),
+		Private:        servicePrivate,
+		Next:           servicev2,
+		PageTokenCodec: v1svc.NewPageTokenCodec(),
 	}
 
 	v1pb.RegisterPeopleServer(server, servicev1)
+	var fieldsv1 *v1svc.FieldConverters
 	for _, opt := range options {
 		switch opt.Name() {
 		case privatesvc.ValidatorName:
@@ -47,10 +50,26 @@ func RegisterServer(server *grpc.Server, impl privatepb.PeopleServer, options ..
 			servicev2.Validator = opt.(v2svc.Validator)
 		case v2svc.ConverterName:
 			servicev2.Converter = opt.(v2svc.Converter)
+		case v2svc.PageTokenCodecName:
+			servicev2.PageTokenCodec = opt.(v2svc.PageTokenCodec)
 		case v1svc.ValidatorName:
 			servicev1.Validator = opt.(v1svc.Validator)
 		case v1svc.ConverterName:
 			servicev1.Converter = opt.(v1svc.Converter)
+		case v1svc.FieldConvertersName:
+			fields := opt.(v1svc.FieldConverters)
+			fieldsv1 = &fields
+		case v1svc.PageTokenCodecName:
+			servicev1.PageTokenCodec = opt.(v1svc.PageTokenCodec)
+		case v1svc.UpsertHookName:
+			servicev1.UpsertHook = opt.(v1svc.UpsertHook)
 		}
 	}
+
+	// Field converters are set once every option is applied, so a
+	// converter option embedding a default converter receives them
+	// whatever the order of the options.
+	if fieldsv1 != nil {
+		servicev1.Converter.SetFieldConverters(*fieldsv1)
+	}
 }
@@ -0,0 +1,106 @@
+// Code generated by protoc-gen-go-svc. DO NOT EDIT.
+// source: example.v1
+
+export interface Person {
+  id?: string;
+  firstName?: string;
+  lastName?: string;
+  employment?: "UNSET" | "EMPLOYED" | "UNEMPLOYED";
+  createdAt?: string;
+  updatedAt?: string;
+  hobby?: Hobby;
+  nickname?: string;
+  age?: string;
+  city?: string;
+  address?: Address;
+  phone?: Phone;
+  previousEmployment?: "UNSET" | "EMPLOYED" | "UNEMPLOYED";
+}
+
+export interface Address {
+  street?: string;
+}
+
+export interface Phone {
+  number?: string;
+}
+
+export interface Hobby {
+  coding?: Coding;
+  reading?: Reading;
+  biking?: Biking;
+}
+
+export interface Coding {
+  language?: string;
+}
+
+export interface Reading {
+  genre?: string;
+}
+
+export interface Biking {
+  style?: string;
+}
+
+export interface CreateRequest {
+  id?: string;
+  firstName?: string;
+  lastName?: string;
+  employment?: "UNSET" | "EMPLOYED" | "UNEMPLOYED";
+  hobby?: Hobby;
+  nickname?: string;
+}
+
+export interface CreateResponse {
+  person?: Person;
+}
+
+export interface GetRequest {
+  id?: string;
+}
+
+export interface GetResponse {
+  person?: Person;
+}
+
+export interface DeleteRequest {
+  id?: string;
+}
+
+export interface DeleteResponse {
+}
+
+export interface ListRequest {
+  offset?: number;
+  limit?: number;
+  /**
+   * created_after is a date formatted as YYYY-MM-DD.
+   */
+  createdAfter?: string;
+}
+
+export interface ListResponse {
+  people?: Array<Person>;
+}
+
+export interface UpsertRequest {
+  id?: string;
+  person?: Person;
+}
+
+export interface UpsertResponse {
+  person?: Person;
+}
+
+export interface SearchRequest {
+  pageSize?: number;
+  pageToken?: string;
+  firstName?: string;
+  lastName?: string;
+}
+
+export interface SearchResponse {
+  people?: Array<Person>;
+  nextPageToken?: string;
+}
@@ -5,14 +5,27 @@ package v1
 
 import (
 	context "context"
+	base64 "encoding/base64"
 	errors "errors"
+	fmt "fmt"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+	time "time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	is "github.com/go-ozzo/ozzo-validation/v4/is"
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	extemptypb "google.golang.org/protobuf/types/known/emptypb"
 	exttimestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	extwrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 
 	privatepb "github.com/dane/protoc-gen-go-svc/example/proto/go/private"
 	private "github.com/dane/protoc-gen-go-svc/example/proto/go/service/private"
@@ -23,11 +36,23 @@ import (
 
 var (
 	_ = errors.New
+	_ = fmt.Errorf
+	_ = time.Parse
 	_ = context.Background
 	_ = validation.Validate
 	_ = is.Int
 	_ = codes.OK
 	_ = status.Errorf
+	_ = proto.Merge
+	_ = strings.IndexByte
+	_ = (*sync.WaitGroup)(nil)
+	_ = base64.RawURLEncoding
+	_ = strconv.FormatInt
+	_ = (*errdetails.BadRequest)(nil)
+	_ = anypb.New
+	_ = durationpb.New
+	_ = timestamppb.New
+	_ = wrapperspb.String
 	_ = privatepb.RegisterPeopleServer
 	_ = publicpb.RegisterPeopleServer
 	_ = private.ValidatorName
@@ -36,108 +61,207 @@ var (
 )
 
 const (
-	ConverterName = "example.v1.Converter"
-	ValidatorName = "example.v1.Validator"
+	ConverterName       = "example.v1.Converter"
+	ValidatorName       = "example.v1.Validator"
+	PageTokenCodecName  = "example.v1.PageTokenCodec"
+	FieldConvertersName = "example.v1.FieldConverters"
+	UpsertHookName      = "example.v1.UpsertHook"
 )
 
 type Service struct {
 	Validator
 	Converter
 	publicpb.PeopleServer
-	Private *private.Service
-	Next    *next.Service
+	Private        *private.Service
+	Next           *next.Service
+	PageTokenCodec PageTokenCodec
+	UpsertHook     UpsertHook
 }
 
 func NewConverter() Converter {
-	return converter{}
+	return converter{fields: &FieldConverters{}}
+}
+
+func NewConverterWithFieldConverters(fields FieldConverters) Converter {
+	return converter{fields: &fields}
+}
+
+type FieldConverters struct {
+	DateToPrivate   func(string) (*exttimestamppb.Timestamp, error)
+	DateFromPrivate func(*exttimestamppb.Timestamp) (string, error)
+	QueryCompose    func(string, string) (string, error)
+	QuerySplit      func(string) (string, string, error)
+}
+
+func (f FieldConverters) Name() string {
+	return FieldConvertersName
 }
 
 type Converter interface {
 	Name() string
+	SetFieldConverters(FieldConverters)
 	ToPublicPerson(*nextpb.Person, *privatepb.Person) (*publicpb.Person, error)
+	ToPublicPersonFieldPath(string) string
 	ToDeprecatedPublicPerson(*privatepb.Person) (*publicpb.Person, error)
-	ToPrivatePerson(*publicpb.Person) *privatepb.Person
+	ToDeprecatedPublicPersonFieldPath(string) string
+	ToPrivatePerson(*publicpb.Person) (*privatepb.Person, error)
+
+	ToNextPerson(*publicpb.Person) (*nextpb.Person, error)
+	ToDeprecatedPublicAddress(*privatepb.Contact) (*publicpb.Address, error)
+	ToDeprecatedPublicAddressFieldPath(string) string
+	ToPrivateContactFromAddress(*publicpb.Address) (*privatepb.Contact, error)
+
+	ToDeprecatedPublicPhone(*privatepb.Contact) (*publicpb.Phone, error)
+	ToDeprecatedPublicPhoneFieldPath(string) string
+	ToPrivateContactFromPhone(*publicpb.Phone) (*privatepb.Contact, error)
 
-	ToNextPerson(*publicpb.Person) *nextpb.Person
 	ToPublicHobby(*nextpb.Hobby, *privatepb.Hobby) (*publicpb.Hobby, error)
+	ToPublicHobbyFieldPath(string) string
 	ToDeprecatedPublicHobby(*privatepb.Hobby) (*publicpb.Hobby, error)
-	ToPrivateHobby(*publicpb.Hobby) *privatepb.Hobby
+	ToDeprecatedPublicHobbyFieldPath(string) string
+	ToPrivateHobby(*publicpb.Hobby) (*privatepb.Hobby, error)
 
-	ToNextHobby(*publicpb.Hobby) *nextpb.Hobby
+	ToNextHobby(*publicpb.Hobby) (*nextpb.Hobby, error)
 	ToPublicCoding(*nextpb.Coding, *privatepb.Coding) (*publicpb.Coding, error)
+	ToPublicCodingFieldPath(string) string
 	ToDeprecatedPublicCoding(*privatepb.Coding) (*publicpb.Coding, error)
-	ToPrivateCoding(*publicpb.Coding) *privatepb.Coding
+	ToDeprecatedPublicCodingFieldPath(string) string
+	ToPrivateCoding(*publicpb.Coding) (*privatepb.Coding, error)
 
-	ToNextCoding(*publicpb.Coding) *nextpb.Coding
+	ToNextCoding(*publicpb.Coding) (*nextpb.Coding, error)
 	ToPublicReading(*nextpb.Reading, *privatepb.Reading) (*publicpb.Reading, error)
+	ToPublicReadingFieldPath(string) string
 	ToDeprecatedPublicReading(*privatepb.Reading) (*publicpb.Reading, error)
-	ToPrivateReading(*publicpb.Reading) *privatepb.Reading
+	ToDeprecatedPublicReadingFieldPath(string) string
+	ToPrivateReading(*publicpb.Reading) (*privatepb.Reading, error)
 
-	ToNextReading(*publicpb.Reading) *nextpb.Reading
+	ToNextReading(*publicpb.Reading) (*nextpb.Reading, error)
 	ToPublicBiking(*nextpb.Cycling, *privatepb.Cycling) (*publicpb.Biking, error)
+	ToPublicBikingFieldPath(string) string
 	ToDeprecatedPublicBiking(*privatepb.Cycling) (*publicpb.Biking, error)
-	ToPrivateCycling(*publicpb.Biking) *privatepb.Cycling
+	ToDeprecatedPublicBikingFieldPath(string) string
+	ToPrivateCycling(*publicpb.Biking) (*privatepb.Cycling, error)
 
-	ToNextCycling(*publicpb.Biking) *nextpb.Cycling
+	ToNextCycling(*publicpb.Biking) (*nextpb.Cycling, error)
 	ToPublicCreateRequest(*nextpb.CreateRequest, *privatepb.CreateRequest) (*publicpb.CreateRequest, error)
+	ToPublicCreateRequestFieldPath(string) string
 	ToDeprecatedPublicCreateRequest(*privatepb.CreateRequest) (*publicpb.CreateRequest, error)
-	ToPrivateCreateRequest(*publicpb.CreateRequest) *privatepb.CreateRequest
+	ToDeprecatedPublicCreateRequestFieldPath(string) string
+	ToPrivateCreateRequest(*publicpb.CreateRequest) (*privatepb.CreateRequest, error)
 
-	ToNextCreateRequest(*publicpb.CreateRequest) *nextpb.CreateRequest
+	ToNextCreateRequest(*publicpb.CreateRequest) (*nextpb.CreateRequest, error)
 	ToPublicCreateResponse(*nextpb.CreateResponse, *privatepb.CreateResponse) (*publicpb.CreateResponse, error)
+	ToPublicCreateResponseFieldPath(string) string
 	ToDeprecatedPublicCreateResponse(*privatepb.CreateResponse) (*publicpb.CreateResponse, error)
-	ToPrivateCreateResponse(*publicpb.CreateResponse) *privatepb.CreateResponse
+	ToDeprecatedPublicCreateResponseFieldPath(string) string
+	ToPrivateCreateResponse(*publicpb.CreateResponse) (*privatepb.CreateResponse, error)
 
-	ToNextCreateResponse(*publicpb.CreateResponse) *nextpb.CreateResponse
+	ToNextCreateResponse(*publicpb.CreateResponse) (*nextpb.CreateResponse, error)
 	ToPublicGetRequest(*nextpb.GetRequest, *privatepb.FetchRequest) (*publicpb.GetRequest, error)
+	ToPublicGetRequestFieldPath(string) string
 	ToDeprecatedPublicGetRequest(*privatepb.FetchRequest) (*publicpb.GetRequest, error)
-	ToPrivateFetchRequest(*publicpb.GetRequest) *privatepb.FetchRequest
+	ToDeprecatedPublicGetRequestFieldPath(string) string
+	ToPrivateFetchRequest(*publicpb.GetRequest) (*privatepb.FetchRequest, error)
 
-	ToNextGetRequest(*publicpb.GetRequest) *nextpb.GetRequest
+	ToNextGetRequest(*publicpb.GetRequest) (*nextpb.GetRequest, error)
 	ToPublicGetResponse(*nextpb.GetResponse, *privatepb.FetchResponse) (*publicpb.GetResponse, error)
+	ToPublicGetResponseFieldPath(string) string
 	ToDeprecatedPublicGetResponse(*privatepb.FetchResponse) (*publicpb.GetResponse, error)
-	ToPrivateFetchResponse(*publicpb.GetResponse) *privatepb.FetchResponse
+	ToDeprecatedPublicGetResponseFieldPath(string) string
+	ToPrivateFetchResponse(*publicpb.GetResponse) (*privatepb.FetchResponse, error)
 
-	ToNextGetResponse(*publicpb.GetResponse) *nextpb.GetResponse
+	ToNextGetResponse(*publicpb.GetResponse) (*nextpb.GetResponse, error)
 	ToPublicDeleteRequest(*nextpb.DeleteRequest, *privatepb.DeleteRequest) (*publicpb.DeleteRequest, error)
+	ToPublicDeleteRequestFieldPath(string) string
 	ToDeprecatedPublicDeleteRequest(*privatepb.DeleteRequest) (*publicpb.DeleteRequest, error)
-	ToPrivateDeleteRequest(*publicpb.DeleteRequest) *privatepb.DeleteRequest
+	ToDeprecatedPublicDeleteRequestFieldPath(string) string
+	ToPrivateDeleteRequest(*publicpb.DeleteRequest) (*privatepb.DeleteRequest, error)
 
-	ToNextDeleteRequest(*publicpb.DeleteRequest) *nextpb.DeleteRequest
+	ToNextDeleteRequest(*publicpb.DeleteRequest) (*nextpb.DeleteRequest, error)
 	ToPublicDeleteResponse(*nextpb.DeleteResponse, *privatepb.DeleteResponse) (*publicpb.DeleteResponse, error)
+	ToPublicDeleteResponseFieldPath(string) string
 	ToDeprecatedPublicDeleteResponse(*privatepb.DeleteResponse) (*publicpb.DeleteResponse, error)
-	ToPrivateDeleteResponse(*publicpb.DeleteResponse) *privatepb.DeleteResponse
+	ToDeprecatedPublicDeleteResponseFieldPath(string) string
+	ToPrivateDeleteResponse(*publicpb.DeleteResponse) (*privatepb.DeleteResponse, error)
 
-	ToNextDeleteResponse(*publicpb.DeleteResponse) *nextpb.DeleteResponse
+	ToNextDeleteResponse(*publicpb.DeleteResponse) (*nextpb.DeleteResponse, error)
 	ToDeprecatedPublicListRequest(*privatepb.ListRequest) (*publicpb.ListRequest, error)
-	ToPrivateListRequest(*publicpb.ListRequest) *privatepb.ListRequest
+	ToDeprecatedPublicListRequestFieldPath(string) string
+	ToPrivateListRequest(*publicpb.ListRequest) (*privatepb.ListRequest, error)
 
 	ToDeprecatedPublicListResponse(*privatepb.ListResponse) (*publicpb.ListResponse, error)
-	ToPrivateListResponse(*publicpb.ListResponse) *privatepb.ListResponse
-
+	ToDeprecatedPublicListResponseFieldPath(string) string
+	ToPrivateListResponse(*publicpb.ListResponse) (*privatepb.ListResponse, error)
+
+	ToDeprecatedPublicUpsertRequest(*privatepb.UpdateRequest) (*publicpb.UpsertRequest, error)
+	ToDeprecatedPublicUpsertRequestFieldPath(string) string
+	ToPrivateUpdateRequest(*publicpb.UpsertRequest) (*privatepb.UpdateRequest, error)
+
+	ToDeprecatedPublicUpsertResponse(*privatepb.UpdateResponse) (*publicpb.UpsertResponse, error)
+	ToDeprecatedPublicUpsertResponseFieldPath(string) string
+	ToPrivateUpdateResponse(*publicpb.UpsertResponse) (*privatepb.UpdateResponse, error)
+
+	ToPublicSearchRequest(*nextpb.SearchRequest, *privatepb.SearchRequest) (*publicpb.SearchRequest, error)
+	ToPublicSearchRequestFieldPath(string) string
+	ToDeprecatedPublicSearchRequest(*privatepb.SearchRequest) (*publicpb.SearchRequest, error)
+	ToDeprecatedPublicSearchRequestFieldPath(string) string
+	ToPrivateSearchRequest(*publicpb.SearchRequest) (*privatepb.SearchRequest, error)
+
+	ToNextSearchRequest(*publicpb.SearchRequest) (*nextpb.SearchRequest, error)
+	ToPublicSearchResponse(*nextpb.SearchResponse, *privatepb.SearchResponse) (*publicpb.SearchResponse, error)
+	ToPublicSearchResponseFieldPath(string) string
+	ToDeprecatedPublicSearchResponse(*privatepb.SearchResponse) (*publicpb.SearchResponse, error)
+	ToDeprecatedPublicSearchResponseFieldPath(string) string
+	ToPrivateSearchResponse(*publicpb.SearchResponse) (*privatepb.SearchResponse, error)
+
+	ToNextSearchResponse(*publicpb.SearchResponse) (*nextpb.SearchResponse, error)
 	ToPublicExternalTimestamp(*exttimestamppb.Timestamp, *exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error)
+	ToPublicExternalTimestampFieldPath(string) string
 	ToDeprecatedPublicExternalTimestamp(*exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error)
-	ToPrivateExternalTimestamp(*exttimestamppb.Timestamp) *exttimestamppb.Timestamp
+	ToDeprecatedPublicExternalTimestampFieldPath(string) string
+	ToPrivateExternalTimestamp(*exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error)
+
+	ToNextExternalTimestamp(*exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error)
+	ToPublicExternalStringValue(*extwrapperspb.StringValue, *extwrapperspb.StringValue) (*extwrapperspb.StringValue, error)
+	ToPublicExternalStringValueFieldPath(string) string
+	ToDeprecatedPublicExternalStringValue(*extwrapperspb.StringValue) (*extwrapperspb.StringValue, error)
+	ToDeprecatedPublicExternalStringValueFieldPath(string) string
+	ToPrivateExternalStringValue(*extwrapperspb.StringValue) (*extwrapperspb.StringValue, error)
 
-	ToNextExternalTimestamp(*exttimestamppb.Timestamp) *exttimestamppb.Timestamp
+	ToNextExternalStringValue(*extwrapperspb.StringValue) (*extwrapperspb.StringValue, error)
 	ToPublicPingInput_ExternalEmpty(*nextpb.PingRequest, *privatepb.PingRequest) (*extemptypb.Empty, error)
+	ToPublicPingInput_ExternalEmptyFieldPath(string) string
 	ToDeprecatedPublicPingInput_ExternalEmpty(*privatepb.PingRequest) (*extemptypb.Empty, error)
-	ToPrivatePingRequest(*extemptypb.Empty) *privatepb.PingRequest
+	ToDeprecatedPublicPingInput_ExternalEmptyFieldPath(string) string
+	ToPrivatePingRequest(*extemptypb.Empty) (*privatepb.PingRequest, error)
 
-	ToNextPingRequest(*extemptypb.Empty) *nextpb.PingRequest
+	ToNextPingRequest(*extemptypb.Empty) (*nextpb.PingRequest, error)
 	ToPublicPingOutput_ExternalEmpty(*nextpb.PingResponse, *privatepb.PingResponse) (*extemptypb.Empty, error)
+	ToPublicPingOutput_ExternalEmptyFieldPath(string) string
 	ToDeprecatedPublicPingOutput_ExternalEmpty(*privatepb.PingResponse) (*extemptypb.Empty, error)
-	ToPrivatePingResponse(*extemptypb.Empty) *privatepb.PingResponse
+	ToDeprecatedPublicPingOutput_ExternalEmptyFieldPath(string) string
+	ToPrivatePingResponse(*extemptypb.Empty) (*privatepb.PingResponse, error)
 
-	ToNextPingResponse(*extemptypb.Empty) *nextpb.PingResponse
+	ToNextPingResponse(*extemptypb.Empty) (*nextpb.PingResponse, error)
 }
 
-type converter struct{}
+type converter struct {
+	fields *FieldConverters
+}
 
 func (c converter) Name() string {
 	return ConverterName
 }
 
+// SetFieldConverters sets the user defined conversions of fields. It is
+// promoted by converters embedding a default converter, so the conversions
+// are set on the embedded converter. Copies of the converter share the
+// conversions.
+func (c converter) SetFieldConverters(fields FieldConverters) {
+	*c.fields = fields
+}
+
+// ToPublicPerson converts example.v2.Person to example.v1.Person.
 func (c converter) ToPublicPerson(in *nextpb.Person, priv *privatepb.Person) (*publicpb.Person, error) {
 	if in == nil {
 		return nil, nil
@@ -176,8 +300,42 @@ func (c converter) ToPublicPerson(in *nextpb.Person, priv *privatepb.Person) (*p
 	if err != nil {
 		return nil, err
 	}
+	if in.Nickname != nil {
+		value := *in.Nickname
+		out.Nickname = &wrapperspb.StringValue{Value: value}
+	}
+	if in.Age != 0 {
+		out.Age = strconv.FormatInt(int64(in.Age), 10)
+	}
+	out.Address, err = c.ToDeprecatedPublicAddress(priv.Contact)
+	if err != nil {
+		return nil, err
+	}
+	out.Phone, err = c.ToDeprecatedPublicPhone(priv.Contact)
+	if err != nil {
+		return nil, err
+	}
+	if in.PreviousEmployment != nil {
+		switch *in.PreviousEmployment {
+		case nextpb.Person_UNSET:
+			out.PreviousEmployment = publicpb.Person_UNSET
+		case nextpb.Person_FULL_TIME:
+			out.PreviousEmployment = publicpb.Person_EMPLOYED
+		case nextpb.Person_PART_TIME:
+			out.PreviousEmployment = publicpb.Person_EMPLOYED
+		case nextpb.Person_UNEMPLOYED:
+			out.PreviousEmployment = publicpb.Person_UNEMPLOYED
+		default:
+			return nil, errors.New(`failed to populate field "PreviousEmployment"`)
+		}
+	}
+	if value := in.GetAddress().GetCity(); value != "" {
+		out.City = value
+	}
 	return &out, err
 }
+
+// ToDeprecatedPublicPerson converts example.private.Person to example.v1.Person.
 func (c converter) ToDeprecatedPublicPerson(priv *privatepb.Person) (*publicpb.Person, error) {
 	if priv == nil {
 		return nil, nil
@@ -216,17 +374,78 @@ func (c converter) ToDeprecatedPublicPerson(priv *privatepb.Person) (*publicpb.P
 	if err != nil {
 		return nil, err
 	}
+	if priv.Nickname != nil {
+		value := *priv.Nickname
+		out.Nickname = &wrapperspb.StringValue{Value: value}
+	}
+	if priv.Age != 0 {
+		out.Age = strconv.FormatInt(int64(priv.Age), 10)
+	}
+	out.Address, err = c.ToDeprecatedPublicAddress(priv.Contact)
+	if err != nil {
+		return nil, err
+	}
+	out.Phone, err = c.ToDeprecatedPublicPhone(priv.Contact)
+	if err != nil {
+		return nil, err
+	}
+	if priv.PreviousEmployment != nil {
+		switch *priv.PreviousEmployment {
+		case privatepb.Person_UNDEFINED:
+			out.PreviousEmployment = publicpb.Person_UNSET
+		case privatepb.Person_FULL_TIME:
+			out.PreviousEmployment = publicpb.Person_EMPLOYED
+		case privatepb.Person_PART_TIME:
+			out.PreviousEmployment = publicpb.Person_EMPLOYED
+		case privatepb.Person_UNEMPLOYED:
+			out.PreviousEmployment = publicpb.Person_UNEMPLOYED
+		default:
+			return nil, errors.New(`failed to populate field "PreviousEmployment"`)
+		}
+	}
+	if value := priv.GetAddress().GetCity(); value != "" {
+		out.City = value
+	}
 	return &out, err
 }
 
-func (c converter) ToPrivatePerson(in *publicpb.Person) *privatepb.Person {
+func (c converter) ToPublicPersonFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "hobby":
+		return joinFieldPath("hobby", index, c.ToPublicHobbyFieldPath(rest))
+	case "contact":
+		return joinFieldPath("address", index, c.ToDeprecatedPublicAddressFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicPersonFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "hobby":
+		return joinFieldPath("hobby", index, c.ToDeprecatedPublicHobbyFieldPath(rest))
+	case "contact":
+		return joinFieldPath("address", index, c.ToDeprecatedPublicAddressFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivatePerson converts example.v1.Person to example.private.Person.
+func (c converter) ToPrivatePerson(in *publicpb.Person) (*privatepb.Person, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.Person
+	var err error
+
 	out.Id = in.Id
+	// example.v1.Person.first_name is deprecated; converted to and from example.private.Person.first_name.
 	out.FirstName = in.FirstName
+	// example.v1.Person.last_name is deprecated; converted to and from example.private.Person.last_name.
 	out.LastName = in.LastName
 	switch in.Employment {
 	case publicpb.Person_UNSET:
@@ -238,16 +457,64 @@ func (c converter) ToPrivatePerson(in *publicpb.Person) *privatepb.Person {
 	}
 	out.CreatedAt = in.CreatedAt
 	out.UpdatedAt = in.UpdatedAt
-	out.Hobby = c.ToPrivateHobby(in.Hobby)
-	return &out
+	out.Hobby, err = c.ToPrivateHobby(in.Hobby)
+	if err != nil {
+		return nil, err
+	}
+	if in.Nickname != nil {
+		value := in.Nickname.Value
+		out.Nickname = &value
+	}
+	if in.Age != "" {
+		value, err := strconv.ParseInt(in.Age, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to convert field "Age": %w`, err)
+		}
+		out.Age = int64(value)
+	}
+	// example.v1.Person.address is deprecated; converted to and from example.private.Person.contact.
+	if value, err := c.ToPrivateContactFromAddress(in.Address); err != nil {
+		return nil, err
+	} else if out.Contact == nil {
+		out.Contact = value
+	} else if value != nil {
+		proto.Merge(out.Contact, value)
+	}
+	// example.v1.Person.phone is deprecated; converted to and from example.private.Person.contact.
+	if value, err := c.ToPrivateContactFromPhone(in.Phone); err != nil {
+		return nil, err
+	} else if out.Contact == nil {
+		out.Contact = value
+	} else if value != nil {
+		proto.Merge(out.Contact, value)
+	}
+	if in.PreviousEmployment != 0 {
+		switch in.PreviousEmployment {
+		case publicpb.Person_UNSET:
+			out.PreviousEmployment = privatepb.Person_UNDEFINED.Enum()
+		case publicpb.Person_EMPLOYED:
+			out.PreviousEmployment = privatepb.Person_FULL_TIME.Enum()
+		case publicpb.Person_UNEMPLOYED:
+			out.PreviousEmployment = privatepb.Person_UNEMPLOYED.Enum()
+		}
+	}
+	if value := in.GetCity(); value != "" {
+		if out.Address == nil {
+			out.Address = &privatepb.Person_Address{}
+		}
+		out.Address.City = value
+	}
+	return &out, err
 }
 
-func (c converter) ToNextPerson(in *publicpb.Person) *nextpb.Person {
+// ToNextPerson converts example.v1.Person to example.v2.Person.
+func (c converter) ToNextPerson(in *publicpb.Person) (*nextpb.Person, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out nextpb.Person
+	var err error
 
 	out.Id = in.Id
 	switch in.Employment {
@@ -260,9 +527,121 @@ func (c converter) ToNextPerson(in *publicpb.Person) *nextpb.Person {
 	}
 	out.CreatedAt = in.CreatedAt
 	out.UpdatedAt = in.UpdatedAt
-	out.Hobby = c.ToNextHobby(in.Hobby)
-	return &out
+	out.Hobby, err = c.ToNextHobby(in.Hobby)
+	if err != nil {
+		return nil, err
+	}
+	if in.Nickname != nil {
+		value := in.Nickname.Value
+		out.Nickname = &value
+	}
+	if in.Age != "" {
+		value, err := strconv.ParseInt(in.Age, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to convert field "Age": %w`, err)
+		}
+		out.Age = int64(value)
+	}
+	if in.PreviousEmployment != 0 {
+		switch in.PreviousEmployment {
+		case publicpb.Person_UNSET:
+			out.PreviousEmployment = nextpb.Person_UNSET.Enum()
+		case publicpb.Person_EMPLOYED:
+			out.PreviousEmployment = nextpb.Person_FULL_TIME.Enum()
+		case publicpb.Person_UNEMPLOYED:
+			out.PreviousEmployment = nextpb.Person_UNEMPLOYED.Enum()
+		}
+	}
+	if value := in.GetCity(); value != "" {
+		if out.Address == nil {
+			out.Address = &nextpb.Person_Address{}
+		}
+		out.Address.City = value
+	}
+	return &out, err
+}
+
+// ToDeprecatedPublicAddress converts example.private.Contact to example.v1.Address.
+func (c converter) ToDeprecatedPublicAddress(priv *privatepb.Contact) (*publicpb.Address, error) {
+	if priv == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.Address
+	var err error
+
+	out.Street = priv.Street
+	return &out, err
+}
+
+func (c converter) ToDeprecatedPublicAddressFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateContactFromAddress converts example.v1.Address to example.private.Contact.
+//
+// example.v1.Address is deprecated; converted to and from example.private.Contact.
+func (c converter) ToPrivateContactFromAddress(in *publicpb.Address) (*privatepb.Contact, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out privatepb.Contact
+	var err error
+
+	out.Street = in.Street
+	return &out, err
+}
+
+// ToDeprecatedPublicPhone converts example.private.Contact to example.v1.Phone.
+func (c converter) ToDeprecatedPublicPhone(priv *privatepb.Contact) (*publicpb.Phone, error) {
+	if priv == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.Phone
+	var err error
+
+	out.Number = priv.PhoneNumber
+	return &out, err
+}
+
+func (c converter) ToDeprecatedPublicPhoneFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "phone_number":
+		return joinFieldPath("number", index, rest)
+	}
+
+	return path
+}
+
+// ToPrivateContactFromPhone converts example.v1.Phone to example.private.Contact.
+//
+// example.v1.Phone is deprecated; converted to and from example.private.Contact.
+func (c converter) ToPrivateContactFromPhone(in *publicpb.Phone) (*privatepb.Contact, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out privatepb.Contact
+	var err error
+
+	out.PhoneNumber = in.Number
+	return &out, err
 }
+
+// ToPublicHobby converts example.v2.Hobby to example.v1.Hobby.
 func (c converter) ToPublicHobby(in *nextpb.Hobby, priv *privatepb.Hobby) (*publicpb.Hobby, error) {
 	if in == nil {
 		return nil, nil
@@ -304,6 +683,8 @@ func (c converter) ToPublicHobby(in *nextpb.Hobby, priv *privatepb.Hobby) (*publ
 	}
 	return &out, err
 }
+
+// ToDeprecatedPublicHobby converts example.private.Hobby to example.v1.Hobby.
 func (c converter) ToDeprecatedPublicHobby(priv *privatepb.Hobby) (*publicpb.Hobby, error) {
 	if priv == nil {
 		return nil, nil
@@ -346,52 +727,111 @@ func (c converter) ToDeprecatedPublicHobby(priv *privatepb.Hobby) (*publicpb.Hob
 	return &out, err
 }
 
-func (c converter) ToPrivateHobby(in *publicpb.Hobby) *privatepb.Hobby {
+func (c converter) ToPublicHobbyFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "coding":
+		return joinFieldPath("coding", index, c.ToPublicCodingFieldPath(rest))
+	case "reading":
+		return joinFieldPath("reading", index, c.ToPublicReadingFieldPath(rest))
+	case "cycling":
+		return joinFieldPath("biking", index, c.ToPublicBikingFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicHobbyFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "coding":
+		return joinFieldPath("coding", index, c.ToDeprecatedPublicCodingFieldPath(rest))
+	case "reading":
+		return joinFieldPath("reading", index, c.ToDeprecatedPublicReadingFieldPath(rest))
+	case "cycling":
+		return joinFieldPath("biking", index, c.ToDeprecatedPublicBikingFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateHobby converts example.v1.Hobby to example.private.Hobby.
+func (c converter) ToPrivateHobby(in *publicpb.Hobby) (*privatepb.Hobby, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.Hobby
+	var err error
+
 	switch in.Type.(type) {
 	case *publicpb.Hobby_Coding:
+		value, err := c.ToPrivateCoding(in.GetCoding())
+		if err != nil {
+			return nil, err
+		}
 		out.Type = &privatepb.Hobby_Coding{
-			Coding: c.ToPrivateCoding(in.GetCoding()),
+			Coding: value,
 		}
 	case *publicpb.Hobby_Reading:
+		value, err := c.ToPrivateReading(in.GetReading())
+		if err != nil {
+			return nil, err
+		}
 		out.Type = &privatepb.Hobby_Reading{
-			Reading: c.ToPrivateReading(in.GetReading()),
+			Reading: value,
 		}
 	case *publicpb.Hobby_Biking:
+		value, err := c.ToPrivateCycling(in.GetBiking())
+		if err != nil {
+			return nil, err
+		}
 		out.Type = &privatepb.Hobby_Cycling{
-			Cycling: c.ToPrivateCycling(in.GetBiking()),
+			Cycling: value,
 		}
 	}
-	return &out
+	return &out, err
 }
 
-func (c converter) ToNextHobby(in *publicpb.Hobby) *nextpb.Hobby {
+// ToNextHobby converts example.v1.Hobby to example.v2.Hobby.
+func (c converter) ToNextHobby(in *publicpb.Hobby) (*nextpb.Hobby, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out nextpb.Hobby
+	var err error
 
 	switch in.Type.(type) {
 	case *publicpb.Hobby_Coding:
+		value, err := c.ToNextCoding(in.GetCoding())
+		if err != nil {
+			return nil, err
+		}
 		out.Type = &nextpb.Hobby_Coding{
-			Coding: c.ToNextCoding(in.GetCoding()),
+			Coding: value,
 		}
 	case *publicpb.Hobby_Reading:
+		value, err := c.ToNextReading(in.GetReading())
+		if err != nil {
+			return nil, err
+		}
 		out.Type = &nextpb.Hobby_Reading{
-			Reading: c.ToNextReading(in.GetReading()),
+			Reading: value,
 		}
 	case *publicpb.Hobby_Biking:
+		value, err := c.ToNextCycling(in.GetBiking())
+		if err != nil {
+			return nil, err
+		}
 		out.Type = &nextpb.Hobby_Cycling{
-			Cycling: c.ToNextCycling(in.GetBiking()),
+			Cycling: value,
 		}
 	}
-	return &out
+	return &out, err
 }
+
+// ToPublicCoding converts example.v2.Coding to example.v1.Coding.
 func (c converter) ToPublicCoding(in *nextpb.Coding, priv *privatepb.Coding) (*publicpb.Coding, error) {
 	if in == nil {
 		return nil, nil
@@ -408,6 +848,8 @@ func (c converter) ToPublicCoding(in *nextpb.Coding, priv *privatepb.Coding) (*p
 	out.Language = in.Language
 	return &out, err
 }
+
+// ToDeprecatedPublicCoding converts example.private.Coding to example.v1.Coding.
 func (c converter) ToDeprecatedPublicCoding(priv *privatepb.Coding) (*publicpb.Coding, error) {
 	if priv == nil {
 		return nil, nil
@@ -425,26 +867,41 @@ func (c converter) ToDeprecatedPublicCoding(priv *privatepb.Coding) (*publicpb.C
 	return &out, err
 }
 
-func (c converter) ToPrivateCoding(in *publicpb.Coding) *privatepb.Coding {
+func (c converter) ToPublicCodingFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicCodingFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateCoding converts example.v1.Coding to example.private.Coding.
+func (c converter) ToPrivateCoding(in *publicpb.Coding) (*privatepb.Coding, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.Coding
+	var err error
+
 	out.Language = in.Language
-	return &out
+	return &out, err
 }
 
-func (c converter) ToNextCoding(in *publicpb.Coding) *nextpb.Coding {
+// ToNextCoding converts example.v1.Coding to example.v2.Coding.
+func (c converter) ToNextCoding(in *publicpb.Coding) (*nextpb.Coding, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out nextpb.Coding
+	var err error
 
 	out.Language = in.Language
-	return &out
+	return &out, err
 }
+
+// ToPublicReading converts example.v2.Reading to example.v1.Reading.
 func (c converter) ToPublicReading(in *nextpb.Reading, priv *privatepb.Reading) (*publicpb.Reading, error) {
 	if in == nil {
 		return nil, nil
@@ -461,6 +918,8 @@ func (c converter) ToPublicReading(in *nextpb.Reading, priv *privatepb.Reading)
 	out.Genre = in.Genre
 	return &out, err
 }
+
+// ToDeprecatedPublicReading converts example.private.Reading to example.v1.Reading.
 func (c converter) ToDeprecatedPublicReading(priv *privatepb.Reading) (*publicpb.Reading, error) {
 	if priv == nil {
 		return nil, nil
@@ -478,26 +937,41 @@ func (c converter) ToDeprecatedPublicReading(priv *privatepb.Reading) (*publicpb
 	return &out, err
 }
 
-func (c converter) ToPrivateReading(in *publicpb.Reading) *privatepb.Reading {
+func (c converter) ToPublicReadingFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicReadingFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateReading converts example.v1.Reading to example.private.Reading.
+func (c converter) ToPrivateReading(in *publicpb.Reading) (*privatepb.Reading, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.Reading
+	var err error
+
 	out.Genre = in.Genre
-	return &out
+	return &out, err
 }
 
-func (c converter) ToNextReading(in *publicpb.Reading) *nextpb.Reading {
+// ToNextReading converts example.v1.Reading to example.v2.Reading.
+func (c converter) ToNextReading(in *publicpb.Reading) (*nextpb.Reading, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out nextpb.Reading
+	var err error
 
 	out.Genre = in.Genre
-	return &out
+	return &out, err
 }
+
+// ToPublicBiking converts example.v2.Cycling to example.v1.Biking.
 func (c converter) ToPublicBiking(in *nextpb.Cycling, priv *privatepb.Cycling) (*publicpb.Biking, error) {
 	if in == nil {
 		return nil, nil
@@ -514,6 +988,8 @@ func (c converter) ToPublicBiking(in *nextpb.Cycling, priv *privatepb.Cycling) (
 	out.Style = in.Style
 	return &out, err
 }
+
+// ToDeprecatedPublicBiking converts example.private.Cycling to example.v1.Biking.
 func (c converter) ToDeprecatedPublicBiking(priv *privatepb.Cycling) (*publicpb.Biking, error) {
 	if priv == nil {
 		return nil, nil
@@ -531,26 +1007,41 @@ func (c converter) ToDeprecatedPublicBiking(priv *privatepb.Cycling) (*publicpb.
 	return &out, err
 }
 
-func (c converter) ToPrivateCycling(in *publicpb.Biking) *privatepb.Cycling {
+func (c converter) ToPublicBikingFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicBikingFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateCycling converts example.v1.Biking to example.private.Cycling.
+func (c converter) ToPrivateCycling(in *publicpb.Biking) (*privatepb.Cycling, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.Cycling
+	var err error
+
 	out.Style = in.Style
-	return &out
+	return &out, err
 }
 
-func (c converter) ToNextCycling(in *publicpb.Biking) *nextpb.Cycling {
+// ToNextCycling converts example.v1.Biking to example.v2.Cycling.
+func (c converter) ToNextCycling(in *publicpb.Biking) (*nextpb.Cycling, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out nextpb.Cycling
+	var err error
 
 	out.Style = in.Style
-	return &out
+	return &out, err
 }
+
+// ToPublicCreateRequest converts example.v2.CreateRequest to example.v1.CreateRequest.
 func (c converter) ToPublicCreateRequest(in *nextpb.CreateRequest, priv *privatepb.CreateRequest) (*publicpb.CreateRequest, error) {
 	if in == nil {
 		return nil, nil
@@ -583,8 +1074,14 @@ func (c converter) ToPublicCreateRequest(in *nextpb.CreateRequest, priv *private
 	if err != nil {
 		return nil, err
 	}
+	if in.Nickname != nil {
+		value := *in.Nickname
+		out.Nickname = &wrapperspb.StringValue{Value: value}
+	}
 	return &out, err
 }
+
+// ToDeprecatedPublicCreateRequest converts example.private.CreateRequest to example.v1.CreateRequest.
 func (c converter) ToDeprecatedPublicCreateRequest(priv *privatepb.CreateRequest) (*publicpb.CreateRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -617,17 +1114,46 @@ func (c converter) ToDeprecatedPublicCreateRequest(priv *privatepb.CreateRequest
 	if err != nil {
 		return nil, err
 	}
+	if priv.Nickname != nil {
+		value := *priv.Nickname
+		out.Nickname = &wrapperspb.StringValue{Value: value}
+	}
 	return &out, err
 }
 
-func (c converter) ToPrivateCreateRequest(in *publicpb.CreateRequest) *privatepb.CreateRequest {
+func (c converter) ToPublicCreateRequestFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "hobby":
+		return joinFieldPath("hobby", index, c.ToPublicHobbyFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicCreateRequestFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "hobby":
+		return joinFieldPath("hobby", index, c.ToDeprecatedPublicHobbyFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateCreateRequest converts example.v1.CreateRequest to example.private.CreateRequest.
+func (c converter) ToPrivateCreateRequest(in *publicpb.CreateRequest) (*privatepb.CreateRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.CreateRequest
+	var err error
+
 	out.Id = in.Id
+	// example.v1.CreateRequest.first_name is deprecated; converted to and from example.private.CreateRequest.first_name.
 	out.FirstName = in.FirstName
+	// example.v1.CreateRequest.last_name is deprecated; converted to and from example.private.CreateRequest.last_name.
 	out.LastName = in.LastName
 	switch in.Employment {
 	case publicpb.Person_UNSET:
@@ -637,16 +1163,25 @@ func (c converter) ToPrivateCreateRequest(in *publicpb.CreateRequest) *privatepb
 	case publicpb.Person_UNEMPLOYED:
 		out.Employment = privatepb.Person_UNEMPLOYED
 	}
-	out.Hobby = c.ToPrivateHobby(in.Hobby)
-	return &out
+	out.Hobby, err = c.ToPrivateHobby(in.Hobby)
+	if err != nil {
+		return nil, err
+	}
+	if in.Nickname != nil {
+		value := in.Nickname.Value
+		out.Nickname = &value
+	}
+	return &out, err
 }
 
-func (c converter) ToNextCreateRequest(in *publicpb.CreateRequest) *nextpb.CreateRequest {
+// ToNextCreateRequest converts example.v1.CreateRequest to example.v2.CreateRequest.
+func (c converter) ToNextCreateRequest(in *publicpb.CreateRequest) (*nextpb.CreateRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out nextpb.CreateRequest
+	var err error
 
 	out.Id = in.Id
 	switch in.Employment {
@@ -657,9 +1192,29 @@ func (c converter) ToNextCreateRequest(in *publicpb.CreateRequest) *nextpb.Creat
 	case publicpb.Person_UNEMPLOYED:
 		out.Employment = nextpb.Person_UNEMPLOYED
 	}
-	out.Hobby = c.ToNextHobby(in.Hobby)
-	return &out
+	out.Hobby, err = c.ToNextHobby(in.Hobby)
+	if err != nil {
+		return nil, err
+	}
+	if in.Nickname != nil {
+		value := in.Nickname.Value
+		out.Nickname = &value
+	}
+	if in.FirstName != "" || in.LastName != "" {
+		// Empty fields are skipped, so a partial input is not joined
+		// with a leading or trailing separator.
+		var parts []string
+		for _, part := range []string{in.FirstName, in.LastName} {
+			if part != "" {
+				parts = append(parts, part)
+			}
+		}
+		out.FullName = strings.Join(parts, " ")
+	}
+	return &out, err
 }
+
+// ToPublicCreateResponse converts example.v2.CreateResponse to example.v1.CreateResponse.
 func (c converter) ToPublicCreateResponse(in *nextpb.CreateResponse, priv *privatepb.CreateResponse) (*publicpb.CreateResponse, error) {
 	if in == nil {
 		return nil, nil
@@ -679,6 +1234,8 @@ func (c converter) ToPublicCreateResponse(in *nextpb.CreateResponse, priv *priva
 	}
 	return &out, err
 }
+
+// ToDeprecatedPublicCreateResponse converts example.private.CreateResponse to example.v1.CreateResponse.
 func (c converter) ToDeprecatedPublicCreateResponse(priv *privatepb.CreateResponse) (*publicpb.CreateResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -699,27 +1256,60 @@ func (c converter) ToDeprecatedPublicCreateResponse(priv *privatepb.CreateRespon
 	return &out, err
 }
 
-func (c converter) ToPrivateCreateResponse(in *publicpb.CreateResponse) *privatepb.CreateResponse {
-	if in == nil {
-		return nil
+func (c converter) ToPublicCreateResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToPublicPersonFieldPath(rest))
 	}
 
-	var out privatepb.CreateResponse
-	out.Person = c.ToPrivatePerson(in.Person)
-	return &out
+	return path
 }
 
-func (c converter) ToNextCreateResponse(in *publicpb.CreateResponse) *nextpb.CreateResponse {
-	if in == nil {
-		return nil
+func (c converter) ToDeprecatedPublicCreateResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToDeprecatedPublicPersonFieldPath(rest))
 	}
 
-	var out nextpb.CreateResponse
-
-	out.Person = c.ToNextPerson(in.Person)
-	return &out
+	return path
 }
-func (c converter) ToPublicGetRequest(in *nextpb.GetRequest, priv *privatepb.FetchRequest) (*publicpb.GetRequest, error) {
+
+// ToPrivateCreateResponse converts example.v1.CreateResponse to example.private.CreateResponse.
+func (c converter) ToPrivateCreateResponse(in *publicpb.CreateResponse) (*privatepb.CreateResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out privatepb.CreateResponse
+	var err error
+
+	out.Person, err = c.ToPrivatePerson(in.Person)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+// ToNextCreateResponse converts example.v1.CreateResponse to example.v2.CreateResponse.
+func (c converter) ToNextCreateResponse(in *publicpb.CreateResponse) (*nextpb.CreateResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out nextpb.CreateResponse
+	var err error
+
+	out.Person, err = c.ToNextPerson(in.Person)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+// ToPublicGetRequest converts example.v2.GetRequest to example.v1.GetRequest.
+func (c converter) ToPublicGetRequest(in *nextpb.GetRequest, priv *privatepb.FetchRequest) (*publicpb.GetRequest, error) {
 	if in == nil {
 		return nil, nil
 	}
@@ -735,6 +1325,8 @@ func (c converter) ToPublicGetRequest(in *nextpb.GetRequest, priv *privatepb.Fet
 	out.Id = in.Id
 	return &out, err
 }
+
+// ToDeprecatedPublicGetRequest converts example.private.FetchRequest to example.v1.GetRequest.
 func (c converter) ToDeprecatedPublicGetRequest(priv *privatepb.FetchRequest) (*publicpb.GetRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -752,26 +1344,41 @@ func (c converter) ToDeprecatedPublicGetRequest(priv *privatepb.FetchRequest) (*
 	return &out, err
 }
 
-func (c converter) ToPrivateFetchRequest(in *publicpb.GetRequest) *privatepb.FetchRequest {
+func (c converter) ToPublicGetRequestFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicGetRequestFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateFetchRequest converts example.v1.GetRequest to example.private.FetchRequest.
+func (c converter) ToPrivateFetchRequest(in *publicpb.GetRequest) (*privatepb.FetchRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.FetchRequest
+	var err error
+
 	out.Id = in.Id
-	return &out
+	return &out, err
 }
 
-func (c converter) ToNextGetRequest(in *publicpb.GetRequest) *nextpb.GetRequest {
+// ToNextGetRequest converts example.v1.GetRequest to example.v2.GetRequest.
+func (c converter) ToNextGetRequest(in *publicpb.GetRequest) (*nextpb.GetRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out nextpb.GetRequest
+	var err error
 
 	out.Id = in.Id
-	return &out
+	return &out, err
 }
+
+// ToPublicGetResponse converts example.v2.GetResponse to example.v1.GetResponse.
 func (c converter) ToPublicGetResponse(in *nextpb.GetResponse, priv *privatepb.FetchResponse) (*publicpb.GetResponse, error) {
 	if in == nil {
 		return nil, nil
@@ -791,6 +1398,8 @@ func (c converter) ToPublicGetResponse(in *nextpb.GetResponse, priv *privatepb.F
 	}
 	return &out, err
 }
+
+// ToDeprecatedPublicGetResponse converts example.private.FetchResponse to example.v1.GetResponse.
 func (c converter) ToDeprecatedPublicGetResponse(priv *privatepb.FetchResponse) (*publicpb.GetResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -811,26 +1420,59 @@ func (c converter) ToDeprecatedPublicGetResponse(priv *privatepb.FetchResponse)
 	return &out, err
 }
 
-func (c converter) ToPrivateFetchResponse(in *publicpb.GetResponse) *privatepb.FetchResponse {
+func (c converter) ToPublicGetResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicGetResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToDeprecatedPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateFetchResponse converts example.v1.GetResponse to example.private.FetchResponse.
+func (c converter) ToPrivateFetchResponse(in *publicpb.GetResponse) (*privatepb.FetchResponse, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.FetchResponse
-	out.Person = c.ToPrivatePerson(in.Person)
-	return &out
+	var err error
+
+	out.Person, err = c.ToPrivatePerson(in.Person)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
-func (c converter) ToNextGetResponse(in *publicpb.GetResponse) *nextpb.GetResponse {
+// ToNextGetResponse converts example.v1.GetResponse to example.v2.GetResponse.
+func (c converter) ToNextGetResponse(in *publicpb.GetResponse) (*nextpb.GetResponse, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out nextpb.GetResponse
+	var err error
 
-	out.Person = c.ToNextPerson(in.Person)
-	return &out
+	out.Person, err = c.ToNextPerson(in.Person)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
+
+// ToPublicDeleteRequest converts example.v2.DeleteRequest to example.v1.DeleteRequest.
 func (c converter) ToPublicDeleteRequest(in *nextpb.DeleteRequest, priv *privatepb.DeleteRequest) (*publicpb.DeleteRequest, error) {
 	if in == nil {
 		return nil, nil
@@ -847,6 +1489,8 @@ func (c converter) ToPublicDeleteRequest(in *nextpb.DeleteRequest, priv *private
 	out.Id = in.Id
 	return &out, err
 }
+
+// ToDeprecatedPublicDeleteRequest converts example.private.DeleteRequest to example.v1.DeleteRequest.
 func (c converter) ToDeprecatedPublicDeleteRequest(priv *privatepb.DeleteRequest) (*publicpb.DeleteRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -864,26 +1508,41 @@ func (c converter) ToDeprecatedPublicDeleteRequest(priv *privatepb.DeleteRequest
 	return &out, err
 }
 
-func (c converter) ToPrivateDeleteRequest(in *publicpb.DeleteRequest) *privatepb.DeleteRequest {
+func (c converter) ToPublicDeleteRequestFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicDeleteRequestFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateDeleteRequest converts example.v1.DeleteRequest to example.private.DeleteRequest.
+func (c converter) ToPrivateDeleteRequest(in *publicpb.DeleteRequest) (*privatepb.DeleteRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.DeleteRequest
+	var err error
+
 	out.Id = in.Id
-	return &out
+	return &out, err
 }
 
-func (c converter) ToNextDeleteRequest(in *publicpb.DeleteRequest) *nextpb.DeleteRequest {
+// ToNextDeleteRequest converts example.v1.DeleteRequest to example.v2.DeleteRequest.
+func (c converter) ToNextDeleteRequest(in *publicpb.DeleteRequest) (*nextpb.DeleteRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out nextpb.DeleteRequest
+	var err error
 
 	out.Id = in.Id
-	return &out
+	return &out, err
 }
+
+// ToPublicDeleteResponse converts example.v2.DeleteResponse to example.v1.DeleteResponse.
 func (c converter) ToPublicDeleteResponse(in *nextpb.DeleteResponse, priv *privatepb.DeleteResponse) (*publicpb.DeleteResponse, error) {
 	if in == nil {
 		return nil, nil
@@ -899,6 +1558,8 @@ func (c converter) ToPublicDeleteResponse(in *nextpb.DeleteResponse, priv *priva
 
 	return &out, err
 }
+
+// ToDeprecatedPublicDeleteResponse converts example.private.DeleteResponse to example.v1.DeleteResponse.
 func (c converter) ToDeprecatedPublicDeleteResponse(priv *privatepb.DeleteResponse) (*publicpb.DeleteResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -915,24 +1576,39 @@ func (c converter) ToDeprecatedPublicDeleteResponse(priv *privatepb.DeleteRespon
 	return &out, err
 }
 
-func (c converter) ToPrivateDeleteResponse(in *publicpb.DeleteResponse) *privatepb.DeleteResponse {
+func (c converter) ToPublicDeleteResponseFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicDeleteResponseFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateDeleteResponse converts example.v1.DeleteResponse to example.private.DeleteResponse.
+func (c converter) ToPrivateDeleteResponse(in *publicpb.DeleteResponse) (*privatepb.DeleteResponse, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.DeleteResponse
-	return &out
+	var err error
+
+	return &out, err
 }
 
-func (c converter) ToNextDeleteResponse(in *publicpb.DeleteResponse) *nextpb.DeleteResponse {
+// ToNextDeleteResponse converts example.v1.DeleteResponse to example.v2.DeleteResponse.
+func (c converter) ToNextDeleteResponse(in *publicpb.DeleteResponse) (*nextpb.DeleteResponse, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out nextpb.DeleteResponse
+	var err error
 
-	return &out
+	return &out, err
 }
+
+// ToDeprecatedPublicListRequest converts example.private.ListRequest to example.v1.ListRequest.
 func (c converter) ToDeprecatedPublicListRequest(priv *privatepb.ListRequest) (*publicpb.ListRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -946,18 +1622,40 @@ func (c converter) ToDeprecatedPublicListRequest(priv *privatepb.ListRequest) (*
 	var out publicpb.ListRequest
 	var err error
 
+	if c.fields.DateFromPrivate == nil {
+		return nil, errors.New(`field converter "DateFromPrivate" is not registered`)
+	}
+	if out.CreatedAfter, err = c.fields.DateFromPrivate(priv.CreatedAfter); err != nil {
+		return nil, fmt.Errorf(`failed to convert field "CreatedAfter": %w`, err)
+	}
 	return &out, err
 }
 
-func (c converter) ToPrivateListRequest(in *publicpb.ListRequest) *privatepb.ListRequest {
+func (c converter) ToDeprecatedPublicListRequestFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateListRequest converts example.v1.ListRequest to example.private.ListRequest.
+//
+// example.v1.ListRequest is deprecated; converted to and from example.private.ListRequest.
+func (c converter) ToPrivateListRequest(in *publicpb.ListRequest) (*privatepb.ListRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.ListRequest
-	return &out
+	var err error
+
+	if c.fields.DateToPrivate == nil {
+		return nil, errors.New(`field converter "DateToPrivate" is not registered`)
+	}
+	if out.CreatedAfter, err = c.fields.DateToPrivate(in.CreatedAfter); err != nil {
+		return nil, fmt.Errorf(`failed to convert field "CreatedAfter": %w`, err)
+	}
+	return &out, err
 }
 
+// ToDeprecatedPublicListResponse converts example.private.ListResponse to example.v1.ListResponse.
 func (c converter) ToDeprecatedPublicListResponse(priv *privatepb.ListResponse) (*publicpb.ListResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -981,33 +1679,382 @@ func (c converter) ToDeprecatedPublicListResponse(priv *privatepb.ListResponse)
 	return &out, err
 }
 
-func (c converter) ToPrivateListResponse(in *publicpb.ListResponse) *privatepb.ListResponse {
+func (c converter) ToDeprecatedPublicListResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "people":
+		return joinFieldPath("people", index, c.ToDeprecatedPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateListResponse converts example.v1.ListResponse to example.private.ListResponse.
+//
+// example.v1.ListResponse is deprecated; converted to and from example.private.ListResponse.
+func (c converter) ToPrivateListResponse(in *publicpb.ListResponse) (*privatepb.ListResponse, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.ListResponse
+	var err error
+
 	for _, item := range in.People {
-		conv := c.ToPrivatePerson(item)
+		conv, err := c.ToPrivatePerson(item)
+		if err != nil {
+			return nil, err
+		}
 		out.People = append(out.People, conv)
 	}
-	return &out
+	return &out, err
+}
+
+// ToDeprecatedPublicUpsertRequest converts example.private.UpdateRequest to example.v1.UpsertRequest.
+func (c converter) ToDeprecatedPublicUpsertRequest(priv *privatepb.UpdateRequest) (*publicpb.UpsertRequest, error) {
+	if priv == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.UpsertRequest
+	var err error
+
+	out.Id = priv.Id
+	out.Person, err = c.ToDeprecatedPublicPerson(priv.Person)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c converter) ToDeprecatedPublicUpsertRequestFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToDeprecatedPublicPersonFieldPath(rest))
+	}
+
+	return path
 }
 
+// ToPrivateUpdateRequest converts example.v1.UpsertRequest to example.private.UpdateRequest.
+//
+// example.v1.UpsertRequest is deprecated; converted to and from example.private.UpdateRequest.
+func (c converter) ToPrivateUpdateRequest(in *publicpb.UpsertRequest) (*privatepb.UpdateRequest, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out privatepb.UpdateRequest
+	var err error
+
+	out.Id = in.Id
+	out.Person, err = c.ToPrivatePerson(in.Person)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+// ToDeprecatedPublicUpsertResponse converts example.private.UpdateResponse to example.v1.UpsertResponse.
+func (c converter) ToDeprecatedPublicUpsertResponse(priv *privatepb.UpdateResponse) (*publicpb.UpsertResponse, error) {
+	if priv == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.UpsertResponse
+	var err error
+
+	out.Person, err = c.ToDeprecatedPublicPerson(priv.Person)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+func (c converter) ToDeprecatedPublicUpsertResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToDeprecatedPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateUpdateResponse converts example.v1.UpsertResponse to example.private.UpdateResponse.
+//
+// example.v1.UpsertResponse is deprecated; converted to and from example.private.UpdateResponse.
+func (c converter) ToPrivateUpdateResponse(in *publicpb.UpsertResponse) (*privatepb.UpdateResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out privatepb.UpdateResponse
+	var err error
+
+	out.Person, err = c.ToPrivatePerson(in.Person)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
+}
+
+// ToPublicSearchRequest converts example.v2.SearchRequest to example.v1.SearchRequest.
+func (c converter) ToPublicSearchRequest(in *nextpb.SearchRequest, priv *privatepb.SearchRequest) (*publicpb.SearchRequest, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.SearchRequest
+	var err error
+
+	if c.fields.QuerySplit == nil {
+		return nil, errors.New(`field converter "QuerySplit" is not registered`)
+	}
+	if out.FirstName, out.LastName, err = c.fields.QuerySplit(in.Query); err != nil {
+		return nil, fmt.Errorf(`failed to split field "Query": %w`, err)
+	}
+	return &out, err
+}
+
+// ToDeprecatedPublicSearchRequest converts example.private.SearchRequest to example.v1.SearchRequest.
+func (c converter) ToDeprecatedPublicSearchRequest(priv *privatepb.SearchRequest) (*publicpb.SearchRequest, error) {
+	if priv == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.SearchRequest
+	var err error
+
+	out.FirstName = priv.FirstName
+	out.LastName = priv.LastName
+	return &out, err
+}
+
+func (c converter) ToPublicSearchRequestFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicSearchRequestFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateSearchRequest converts example.v1.SearchRequest to example.private.SearchRequest.
+func (c converter) ToPrivateSearchRequest(in *publicpb.SearchRequest) (*privatepb.SearchRequest, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out privatepb.SearchRequest
+	var err error
+
+	out.FirstName = in.FirstName
+	out.LastName = in.LastName
+	return &out, err
+}
+
+// ToNextSearchRequest converts example.v1.SearchRequest to example.v2.SearchRequest.
+func (c converter) ToNextSearchRequest(in *publicpb.SearchRequest) (*nextpb.SearchRequest, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out nextpb.SearchRequest
+	var err error
+
+	if c.fields.QueryCompose == nil {
+		return nil, errors.New(`field converter "QueryCompose" is not registered`)
+	}
+	if out.Query, err = c.fields.QueryCompose(in.FirstName, in.LastName); err != nil {
+		return nil, fmt.Errorf(`failed to compose field "Query": %w`, err)
+	}
+	return &out, err
+}
+
+// ToPublicSearchResponse converts example.v2.SearchResponse to example.v1.SearchResponse.
+func (c converter) ToPublicSearchResponse(in *nextpb.SearchResponse, priv *privatepb.SearchResponse) (*publicpb.SearchResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.SearchResponse
+	var err error
+
+	for i, item := range in.People {
+		conv, err := c.ToPublicPerson(item, priv.People[i])
+		if err != nil {
+			return nil, err
+		}
+		out.People = append(out.People, conv)
+	}
+	return &out, err
+}
+
+// ToDeprecatedPublicSearchResponse converts example.private.SearchResponse to example.v1.SearchResponse.
+func (c converter) ToDeprecatedPublicSearchResponse(priv *privatepb.SearchResponse) (*publicpb.SearchResponse, error) {
+	if priv == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.SearchResponse
+	var err error
+
+	for _, item := range priv.People {
+		conv, err := c.ToDeprecatedPublicPerson(item)
+		if err != nil {
+			return nil, err
+		}
+		out.People = append(out.People, conv)
+	}
+	return &out, err
+}
+
+func (c converter) ToPublicSearchResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "people":
+		return joinFieldPath("people", index, c.ToPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicSearchResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "people":
+		return joinFieldPath("people", index, c.ToDeprecatedPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateSearchResponse converts example.v1.SearchResponse to example.private.SearchResponse.
+func (c converter) ToPrivateSearchResponse(in *publicpb.SearchResponse) (*privatepb.SearchResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out privatepb.SearchResponse
+	var err error
+
+	for _, item := range in.People {
+		conv, err := c.ToPrivatePerson(item)
+		if err != nil {
+			return nil, err
+		}
+		out.People = append(out.People, conv)
+	}
+	return &out, err
+}
+
+// ToNextSearchResponse converts example.v1.SearchResponse to example.v2.SearchResponse.
+func (c converter) ToNextSearchResponse(in *publicpb.SearchResponse) (*nextpb.SearchResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out nextpb.SearchResponse
+	var err error
+
+	for _, item := range in.People {
+		conv, err := c.ToNextPerson(item)
+		if err != nil {
+			return nil, err
+		}
+		out.People = append(out.People, conv)
+	}
+	return &out, err
+}
+
+// ToPublicExternalTimestamp converts google.protobuf.Timestamp to google.protobuf.Timestamp.
 func (c converter) ToPublicExternalTimestamp(in *exttimestamppb.Timestamp, priv *exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error) {
 	return in, nil
 }
+
+// ToDeprecatedPublicExternalTimestamp converts google.protobuf.Timestamp to google.protobuf.Timestamp.
 func (c converter) ToDeprecatedPublicExternalTimestamp(priv *exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error) {
 	return priv, nil
 }
 
-func (c converter) ToPrivateExternalTimestamp(in *exttimestamppb.Timestamp) *exttimestamppb.Timestamp {
-	return in
+func (c converter) ToPublicExternalTimestampFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicExternalTimestampFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateExternalTimestamp converts google.protobuf.Timestamp to google.protobuf.Timestamp.
+func (c converter) ToPrivateExternalTimestamp(in *exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error) {
+	return in, nil
+}
+
+// ToNextExternalTimestamp converts google.protobuf.Timestamp to google.protobuf.Timestamp.
+func (c converter) ToNextExternalTimestamp(in *exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error) {
+	return in, nil
+}
+
+// ToPublicExternalStringValue converts google.protobuf.StringValue to google.protobuf.StringValue.
+func (c converter) ToPublicExternalStringValue(in *extwrapperspb.StringValue, priv *extwrapperspb.StringValue) (*extwrapperspb.StringValue, error) {
+	return in, nil
+}
+
+// ToDeprecatedPublicExternalStringValue converts google.protobuf.StringValue to google.protobuf.StringValue.
+func (c converter) ToDeprecatedPublicExternalStringValue(priv *extwrapperspb.StringValue) (*extwrapperspb.StringValue, error) {
+	return priv, nil
+}
+
+func (c converter) ToPublicExternalStringValueFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicExternalStringValueFieldPath(path string) string {
+	return path
 }
 
-func (c converter) ToNextExternalTimestamp(in *exttimestamppb.Timestamp) *exttimestamppb.Timestamp {
-	return in
+// ToPrivateExternalStringValue converts google.protobuf.StringValue to google.protobuf.StringValue.
+func (c converter) ToPrivateExternalStringValue(in *extwrapperspb.StringValue) (*extwrapperspb.StringValue, error) {
+	return in, nil
 }
+
+// ToNextExternalStringValue converts google.protobuf.StringValue to google.protobuf.StringValue.
+func (c converter) ToNextExternalStringValue(in *extwrapperspb.StringValue) (*extwrapperspb.StringValue, error) {
+	return in, nil
+}
+
+// ToPublicPingInput_ExternalEmpty converts example.v2.PingRequest to .
 func (c converter) ToPublicPingInput_ExternalEmpty(in *nextpb.PingRequest, priv *privatepb.PingRequest) (*extemptypb.Empty, error) {
 	if in == nil {
 		return nil, nil
@@ -1023,6 +2070,8 @@ func (c converter) ToPublicPingInput_ExternalEmpty(in *nextpb.PingRequest, priv
 
 	return &out, err
 }
+
+// ToDeprecatedPublicPingInput_ExternalEmpty converts example.private.PingRequest to .
 func (c converter) ToDeprecatedPublicPingInput_ExternalEmpty(priv *privatepb.PingRequest) (*extemptypb.Empty, error) {
 	if priv == nil {
 		return nil, nil
@@ -1039,24 +2088,39 @@ func (c converter) ToDeprecatedPublicPingInput_ExternalEmpty(priv *privatepb.Pin
 	return &out, err
 }
 
-func (c converter) ToPrivatePingRequest(in *extemptypb.Empty) *privatepb.PingRequest {
+func (c converter) ToPublicPingInput_ExternalEmptyFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicPingInput_ExternalEmptyFieldPath(path string) string {
+	return path
+}
+
+// ToPrivatePingRequest converts  to example.private.PingRequest.
+func (c converter) ToPrivatePingRequest(in *extemptypb.Empty) (*privatepb.PingRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.PingRequest
-	return &out
+	var err error
+
+	return &out, err
 }
 
-func (c converter) ToNextPingRequest(in *extemptypb.Empty) *nextpb.PingRequest {
+// ToNextPingRequest converts  to example.v2.PingRequest.
+func (c converter) ToNextPingRequest(in *extemptypb.Empty) (*nextpb.PingRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out nextpb.PingRequest
+	var err error
 
-	return &out
+	return &out, err
 }
+
+// ToPublicPingOutput_ExternalEmpty converts example.v2.PingResponse to .
 func (c converter) ToPublicPingOutput_ExternalEmpty(in *nextpb.PingResponse, priv *privatepb.PingResponse) (*extemptypb.Empty, error) {
 	if in == nil {
 		return nil, nil
@@ -1072,6 +2136,8 @@ func (c converter) ToPublicPingOutput_ExternalEmpty(in *nextpb.PingResponse, pri
 
 	return &out, err
 }
+
+// ToDeprecatedPublicPingOutput_ExternalEmpty converts example.private.PingResponse to .
 func (c converter) ToDeprecatedPublicPingOutput_ExternalEmpty(priv *privatepb.PingResponse) (*extemptypb.Empty, error) {
 	if priv == nil {
 		return nil, nil
@@ -1088,23 +2154,101 @@ func (c converter) ToDeprecatedPublicPingOutput_ExternalEmpty(priv *privatepb.Pi
 	return &out, err
 }
 
-func (c converter) ToPrivatePingResponse(in *extemptypb.Empty) *privatepb.PingResponse {
+func (c converter) ToPublicPingOutput_ExternalEmptyFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicPingOutput_ExternalEmptyFieldPath(path string) string {
+	return path
+}
+
+// ToPrivatePingResponse converts  to example.private.PingResponse.
+func (c converter) ToPrivatePingResponse(in *extemptypb.Empty) (*privatepb.PingResponse, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.PingResponse
-	return &out
+	var err error
+
+	return &out, err
 }
 
-func (c converter) ToNextPingResponse(in *extemptypb.Empty) *nextpb.PingResponse {
+// ToNextPingResponse converts  to example.v2.PingResponse.
+func (c converter) ToNextPingResponse(in *extemptypb.Empty) (*nextpb.PingResponse, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out nextpb.PingResponse
+	var err error
+
+	return &out, err
+}
+
+// UpsertHook calls the private service on behalf of Upsert.
+// The input has been validated and converted to the private service.
+// Register an implementation as an `Option`.
+type UpsertHook interface {
+	Name() string
+	Upsert(context.Context, *privatepb.UpdateRequest, *private.Service) (*privatepb.UpdateResponse, error)
+}
+
+func NewPageTokenCodec() PageTokenCodec {
+	return pageTokenCodec{}
+}
+
+// PageTokenCodec converts between offsets and page tokens when a paginated
+// method calls a method with a different pagination style.
+type PageTokenCodec interface {
+	Name() string
+	Encode(int64) string
+	Decode(string) (int64, error)
+}
+
+// pageTokenCodec returns the page token codec of the service, or the default
+// codec when the service was not created by `RegisterServer`.
+func (s *Service) pageTokenCodec() PageTokenCodec {
+	if s.PageTokenCodec == nil {
+		return NewPageTokenCodec()
+	}
+
+	return s.PageTokenCodec
+}
+
+type pageTokenCodec struct{}
 
-	return &out
+func (c pageTokenCodec) Name() string {
+	return PageTokenCodecName
+}
+
+// Encode returns the offset as a base64 encoded page token. An empty page
+// token is returned for the first page.
+func (c pageTokenCodec) Encode(offset int64) string {
+	if offset <= 0 {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(offset, 10)))
+}
+
+// Decode returns the offset of a page token created by `Encode`.
+func (c pageTokenCodec) Decode(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	value, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.New("invalid page token")
+	}
+
+	offset, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid page token")
+	}
+
+	return offset, nil
 }
 
 func NewValidator() Validator {
@@ -1115,6 +2259,10 @@ type Validator interface {
 	Name() string
 	ValidatePerson(*publicpb.Person) error
 	ByPerson(interface{}) error
+	ValidateAddress(*publicpb.Address) error
+	ByAddress(interface{}) error
+	ValidatePhone(*publicpb.Phone) error
+	ByPhone(interface{}) error
 	ValidateHobby(*publicpb.Hobby) error
 	ByHobby(interface{}) error
 	ValidateCoding(*publicpb.Coding) error
@@ -1139,8 +2287,18 @@ type Validator interface {
 	ByListRequest(interface{}) error
 	ValidateListResponse(*publicpb.ListResponse) error
 	ByListResponse(interface{}) error
+	ValidateUpsertRequest(*publicpb.UpsertRequest) error
+	ByUpsertRequest(interface{}) error
+	ValidateUpsertResponse(*publicpb.UpsertResponse) error
+	ByUpsertResponse(interface{}) error
+	ValidateSearchRequest(*publicpb.SearchRequest) error
+	BySearchRequest(interface{}) error
+	ValidateSearchResponse(*publicpb.SearchResponse) error
+	BySearchResponse(interface{}) error
 	ValidateExternalTimestamp(*exttimestamppb.Timestamp) error
 	ByExternalTimestamp(interface{}) error
+	ValidateExternalStringValue(*extwrapperspb.StringValue) error
+	ByExternalStringValue(interface{}) error
 	ValidatePingInput_ExternalEmpty(*extemptypb.Empty) error
 	ByPingInput_ExternalEmpty(interface{}) error
 	ValidatePingOutput_ExternalEmpty(*extemptypb.Empty) error
@@ -1153,6 +2311,7 @@ func (v validator) Name() string {
 	return ValidatorName
 }
 
+// ValidatePerson validates example.v1.Person.
 func (v validator) ValidatePerson(in *publicpb.Person) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id),
@@ -1169,9 +2328,22 @@ func (v validator) ValidatePerson(in *publicpb.Person) error {
 			validation.Required,
 			validation.By(v.ByHobby),
 		),
+		validation.Field(&in.Nickname,
+			validation.By(v.ByExternalStringValue),
+		),
+		validation.Field(&in.Age),
+		validation.Field(&in.City),
+		validation.Field(&in.Address,
+			validation.By(v.ByAddress),
+		),
+		validation.Field(&in.Phone,
+			validation.By(v.ByPhone),
+		),
+		validation.Field(&in.PreviousEmployment),
 	)
 }
 
+// ByPerson validates example.v1.Person as an ozzo-validation rule.
 func (v validator) ByPerson(value interface{}) error {
 	var in *publicpb.Person
 	if v, ok := value.(*publicpb.Person); ok {
@@ -1181,8 +2353,68 @@ func (v validator) ByPerson(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidatePerson(in)
 }
+
+// ValidateAddress validates example.v1.Address.
+func (v validator) ValidateAddress(in *publicpb.Address) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.Street),
+	)
+}
+
+// ByAddress validates example.v1.Address as an ozzo-validation rule.
+func (v validator) ByAddress(value interface{}) error {
+	var in *publicpb.Address
+	if v, ok := value.(*publicpb.Address); ok {
+		in = v
+	} else {
+		v := value.(publicpb.Address)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateAddress(in)
+}
+
+// ValidatePhone validates example.v1.Phone.
+func (v validator) ValidatePhone(in *publicpb.Phone) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.Number),
+	)
+}
+
+// ByPhone validates example.v1.Phone as an ozzo-validation rule.
+func (v validator) ByPhone(value interface{}) error {
+	var in *publicpb.Phone
+	if v, ok := value.(*publicpb.Phone); ok {
+		in = v
+	} else {
+		v := value.(publicpb.Phone)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidatePhone(in)
+}
+
+// ValidateHobby validates example.v1.Hobby.
 func (v validator) ValidateHobby(in *publicpb.Hobby) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Type,
@@ -1191,6 +2423,7 @@ func (v validator) ValidateHobby(in *publicpb.Hobby) error {
 	)
 }
 
+// ByHobby validates example.v1.Hobby as an ozzo-validation rule.
 func (v validator) ByHobby(value interface{}) error {
 	var in *publicpb.Hobby
 	if v, ok := value.(*publicpb.Hobby); ok {
@@ -1200,14 +2433,23 @@ func (v validator) ByHobby(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateHobby(in)
 }
+
+// ValidateCoding validates example.v1.Coding.
 func (v validator) ValidateCoding(in *publicpb.Coding) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Language),
 	)
 }
 
+// ByCoding validates example.v1.Coding as an ozzo-validation rule.
 func (v validator) ByCoding(value interface{}) error {
 	var in *publicpb.Coding
 	if v, ok := value.(*publicpb.Coding); ok {
@@ -1217,14 +2459,23 @@ func (v validator) ByCoding(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateCoding(in)
 }
+
+// ValidateReading validates example.v1.Reading.
 func (v validator) ValidateReading(in *publicpb.Reading) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Genre),
 	)
 }
 
+// ByReading validates example.v1.Reading as an ozzo-validation rule.
 func (v validator) ByReading(value interface{}) error {
 	var in *publicpb.Reading
 	if v, ok := value.(*publicpb.Reading); ok {
@@ -1234,14 +2485,23 @@ func (v validator) ByReading(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateReading(in)
 }
+
+// ValidateBiking validates example.v1.Biking.
 func (v validator) ValidateBiking(in *publicpb.Biking) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Style),
 	)
 }
 
+// ByBiking validates example.v1.Biking as an ozzo-validation rule.
 func (v validator) ByBiking(value interface{}) error {
 	var in *publicpb.Biking
 	if v, ok := value.(*publicpb.Biking); ok {
@@ -1251,8 +2511,16 @@ func (v validator) ByBiking(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateBiking(in)
 }
+
+// ValidateCreateRequest validates example.v1.CreateRequest.
 func (v validator) ValidateCreateRequest(in *publicpb.CreateRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id,
@@ -1272,9 +2540,13 @@ func (v validator) ValidateCreateRequest(in *publicpb.CreateRequest) error {
 			validation.Required,
 			validation.By(v.ByHobby),
 		),
+		validation.Field(&in.Nickname,
+			validation.By(v.ByExternalStringValue),
+		),
 	)
 }
 
+// ByCreateRequest validates example.v1.CreateRequest as an ozzo-validation rule.
 func (v validator) ByCreateRequest(value interface{}) error {
 	var in *publicpb.CreateRequest
 	if v, ok := value.(*publicpb.CreateRequest); ok {
@@ -1284,8 +2556,16 @@ func (v validator) ByCreateRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateCreateRequest(in)
 }
+
+// ValidateCreateResponse validates example.v1.CreateResponse.
 func (v validator) ValidateCreateResponse(in *publicpb.CreateResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Person,
@@ -1294,6 +2574,7 @@ func (v validator) ValidateCreateResponse(in *publicpb.CreateResponse) error {
 	)
 }
 
+// ByCreateResponse validates example.v1.CreateResponse as an ozzo-validation rule.
 func (v validator) ByCreateResponse(value interface{}) error {
 	var in *publicpb.CreateResponse
 	if v, ok := value.(*publicpb.CreateResponse); ok {
@@ -1303,8 +2584,16 @@ func (v validator) ByCreateResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateCreateResponse(in)
 }
+
+// ValidateGetRequest validates example.v1.GetRequest.
 func (v validator) ValidateGetRequest(in *publicpb.GetRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id,
@@ -1314,6 +2603,7 @@ func (v validator) ValidateGetRequest(in *publicpb.GetRequest) error {
 	)
 }
 
+// ByGetRequest validates example.v1.GetRequest as an ozzo-validation rule.
 func (v validator) ByGetRequest(value interface{}) error {
 	var in *publicpb.GetRequest
 	if v, ok := value.(*publicpb.GetRequest); ok {
@@ -1323,8 +2613,16 @@ func (v validator) ByGetRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateGetRequest(in)
 }
+
+// ValidateGetResponse validates example.v1.GetResponse.
 func (v validator) ValidateGetResponse(in *publicpb.GetResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Person,
@@ -1333,6 +2631,7 @@ func (v validator) ValidateGetResponse(in *publicpb.GetResponse) error {
 	)
 }
 
+// ByGetResponse validates example.v1.GetResponse as an ozzo-validation rule.
 func (v validator) ByGetResponse(value interface{}) error {
 	var in *publicpb.GetResponse
 	if v, ok := value.(*publicpb.GetResponse); ok {
@@ -1342,8 +2641,16 @@ func (v validator) ByGetResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateGetResponse(in)
 }
+
+// ValidateDeleteRequest validates example.v1.DeleteRequest.
 func (v validator) ValidateDeleteRequest(in *publicpb.DeleteRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id,
@@ -1353,6 +2660,7 @@ func (v validator) ValidateDeleteRequest(in *publicpb.DeleteRequest) error {
 	)
 }
 
+// ByDeleteRequest validates example.v1.DeleteRequest as an ozzo-validation rule.
 func (v validator) ByDeleteRequest(value interface{}) error {
 	var in *publicpb.DeleteRequest
 	if v, ok := value.(*publicpb.DeleteRequest); ok {
@@ -1362,12 +2670,21 @@ func (v validator) ByDeleteRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateDeleteRequest(in)
 }
+
+// ValidateDeleteResponse validates example.v1.DeleteResponse.
 func (v validator) ValidateDeleteResponse(in *publicpb.DeleteResponse) error {
 	return validation.ValidateStruct(in)
 }
 
+// ByDeleteResponse validates example.v1.DeleteResponse as an ozzo-validation rule.
 func (v validator) ByDeleteResponse(value interface{}) error {
 	var in *publicpb.DeleteResponse
 	if v, ok := value.(*publicpb.DeleteResponse); ok {
@@ -1377,12 +2694,27 @@ func (v validator) ByDeleteResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateDeleteResponse(in)
 }
+
+// ValidateListRequest validates example.v1.ListRequest.
 func (v validator) ValidateListRequest(in *publicpb.ListRequest) error {
-	return validation.ValidateStruct(in)
+	return validation.ValidateStruct(in,
+		validation.Field(&in.Offset),
+		validation.Field(&in.Limit,
+			validation.Max(100),
+		),
+		validation.Field(&in.CreatedAfter),
+	)
 }
 
+// ByListRequest validates example.v1.ListRequest as an ozzo-validation rule.
 func (v validator) ByListRequest(value interface{}) error {
 	var in *publicpb.ListRequest
 	if v, ok := value.(*publicpb.ListRequest); ok {
@@ -1392,8 +2724,16 @@ func (v validator) ByListRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateListRequest(in)
 }
+
+// ValidateListResponse validates example.v1.ListResponse.
 func (v validator) ValidateListResponse(in *publicpb.ListResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.People,
@@ -1402,6 +2742,7 @@ func (v validator) ValidateListResponse(in *publicpb.ListResponse) error {
 	)
 }
 
+// ByListResponse validates example.v1.ListResponse as an ozzo-validation rule.
 func (v validator) ByListResponse(value interface{}) error {
 	var in *publicpb.ListResponse
 	if v, ok := value.(*publicpb.ListResponse); ok {
@@ -1411,12 +2752,142 @@ func (v validator) ByListResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateListResponse(in)
 }
+
+// ValidateUpsertRequest validates example.v1.UpsertRequest.
+func (v validator) ValidateUpsertRequest(in *publicpb.UpsertRequest) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.Id,
+			validation.Required,
+			is.UUID,
+		),
+		validation.Field(&in.Person,
+			validation.Required,
+			validation.By(v.ByPerson),
+		),
+	)
+}
+
+// ByUpsertRequest validates example.v1.UpsertRequest as an ozzo-validation rule.
+func (v validator) ByUpsertRequest(value interface{}) error {
+	var in *publicpb.UpsertRequest
+	if v, ok := value.(*publicpb.UpsertRequest); ok {
+		in = v
+	} else {
+		v := value.(publicpb.UpsertRequest)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateUpsertRequest(in)
+}
+
+// ValidateUpsertResponse validates example.v1.UpsertResponse.
+func (v validator) ValidateUpsertResponse(in *publicpb.UpsertResponse) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.Person,
+			validation.By(v.ByPerson),
+		),
+	)
+}
+
+// ByUpsertResponse validates example.v1.UpsertResponse as an ozzo-validation rule.
+func (v validator) ByUpsertResponse(value interface{}) error {
+	var in *publicpb.UpsertResponse
+	if v, ok := value.(*publicpb.UpsertResponse); ok {
+		in = v
+	} else {
+		v := value.(publicpb.UpsertResponse)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateUpsertResponse(in)
+}
+
+// ValidateSearchRequest validates example.v1.SearchRequest.
+func (v validator) ValidateSearchRequest(in *publicpb.SearchRequest) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.PageSize,
+			validation.Max(100),
+		),
+		validation.Field(&in.PageToken),
+		validation.Field(&in.FirstName),
+		validation.Field(&in.LastName),
+	)
+}
+
+// BySearchRequest validates example.v1.SearchRequest as an ozzo-validation rule.
+func (v validator) BySearchRequest(value interface{}) error {
+	var in *publicpb.SearchRequest
+	if v, ok := value.(*publicpb.SearchRequest); ok {
+		in = v
+	} else {
+		v := value.(publicpb.SearchRequest)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateSearchRequest(in)
+}
+
+// ValidateSearchResponse validates example.v1.SearchResponse.
+func (v validator) ValidateSearchResponse(in *publicpb.SearchResponse) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.People,
+			validation.Each(validation.By(v.ByPerson)),
+		),
+		validation.Field(&in.NextPageToken),
+	)
+}
+
+// BySearchResponse validates example.v1.SearchResponse as an ozzo-validation rule.
+func (v validator) BySearchResponse(value interface{}) error {
+	var in *publicpb.SearchResponse
+	if v, ok := value.(*publicpb.SearchResponse); ok {
+		in = v
+	} else {
+		v := value.(publicpb.SearchResponse)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateSearchResponse(in)
+}
+
+// ValidateExternalTimestamp validates google.protobuf.Timestamp.
 func (v validator) ValidateExternalTimestamp(in *exttimestamppb.Timestamp) error {
 	return nil
 }
 
+// ByExternalTimestamp validates google.protobuf.Timestamp as an ozzo-validation rule.
 func (v validator) ByExternalTimestamp(value interface{}) error {
 	var in *exttimestamppb.Timestamp
 	if v, ok := value.(*exttimestamppb.Timestamp); ok {
@@ -1426,12 +2897,45 @@ func (v validator) ByExternalTimestamp(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateExternalTimestamp(in)
 }
+
+// ValidateExternalStringValue validates google.protobuf.StringValue.
+func (v validator) ValidateExternalStringValue(in *extwrapperspb.StringValue) error {
+	return nil
+}
+
+// ByExternalStringValue validates google.protobuf.StringValue as an ozzo-validation rule.
+func (v validator) ByExternalStringValue(value interface{}) error {
+	var in *extwrapperspb.StringValue
+	if v, ok := value.(*extwrapperspb.StringValue); ok {
+		in = v
+	} else {
+		v := value.(extwrapperspb.StringValue)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateExternalStringValue(in)
+}
+
+// ValidatePingInput_ExternalEmpty validates .
 func (v validator) ValidatePingInput_ExternalEmpty(in *extemptypb.Empty) error {
 	return nil
 }
 
+// ByPingInput_ExternalEmpty validates  as an ozzo-validation rule.
 func (v validator) ByPingInput_ExternalEmpty(value interface{}) error {
 	var in *extemptypb.Empty
 	if v, ok := value.(*extemptypb.Empty); ok {
@@ -1441,12 +2945,21 @@ func (v validator) ByPingInput_ExternalEmpty(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidatePingInput_ExternalEmpty(in)
 }
+
+// ValidatePingOutput_ExternalEmpty validates .
 func (v validator) ValidatePingOutput_ExternalEmpty(in *extemptypb.Empty) error {
 	return nil
 }
 
+// ByPingOutput_ExternalEmpty validates  as an ozzo-validation rule.
 func (v validator) ByPingOutput_ExternalEmpty(value interface{}) error {
 	var in *extemptypb.Empty
 	if v, ok := value.(*extemptypb.Empty); ok {
@@ -1456,9 +2969,18 @@ func (v validator) ByPingOutput_ExternalEmpty(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidatePingOutput_ExternalEmpty(in)
 }
 
+// Create implements example.v1.People.Create.
+//
+// example.v1.People.Create is delegated to example.v2.People.Create.
 func (s *Service) Create(ctx context.Context, in *publicpb.CreateRequest) (*publicpb.CreateResponse, error) {
 	if err := s.ValidateCreateRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -1467,6 +2989,10 @@ func (s *Service) Create(ctx context.Context, in *publicpb.CreateRequest) (*publ
 	out, _, err := s.CreateImpl(ctx, in)
 	return out, err
 }
+
+// Get implements example.v1.People.Get.
+//
+// example.v1.People.Get is delegated to example.v2.People.Get.
 func (s *Service) Get(ctx context.Context, in *publicpb.GetRequest) (*publicpb.GetResponse, error) {
 	if err := s.ValidateGetRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -1475,6 +3001,10 @@ func (s *Service) Get(ctx context.Context, in *publicpb.GetRequest) (*publicpb.G
 	out, _, err := s.GetImpl(ctx, in)
 	return out, err
 }
+
+// Delete implements example.v1.People.Delete.
+//
+// example.v1.People.Delete is delegated to example.v2.People.Delete.
 func (s *Service) Delete(ctx context.Context, in *publicpb.DeleteRequest) (*publicpb.DeleteResponse, error) {
 	if err := s.ValidateDeleteRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -1483,6 +3013,10 @@ func (s *Service) Delete(ctx context.Context, in *publicpb.DeleteRequest) (*publ
 	out, _, err := s.DeleteImpl(ctx, in)
 	return out, err
 }
+
+// List implements example.v1.People.List.
+//
+// example.v1.People.List is deprecated; delegated to example.private.People.List.
 func (s *Service) List(ctx context.Context, in *publicpb.ListRequest) (*publicpb.ListResponse, error) {
 	if err := s.ValidateListRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -1491,6 +3025,10 @@ func (s *Service) List(ctx context.Context, in *publicpb.ListRequest) (*publicpb
 	out, _, err := s.ListImpl(ctx, in)
 	return out, err
 }
+
+// Ping implements example.v1.People.Ping.
+//
+// example.v1.People.Ping is delegated to example.v2.People.Ping.
 func (s *Service) Ping(ctx context.Context, in *extemptypb.Empty) (*extemptypb.Empty, error) {
 	if err := s.ValidatePingInput_ExternalEmpty(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -1500,14 +3038,46 @@ func (s *Service) Ping(ctx context.Context, in *extemptypb.Empty) (*extemptypb.E
 	return out, err
 }
 
+// Search implements example.v1.People.Search.
+//
+// example.v1.People.Search is delegated to example.v2.People.Search.
+func (s *Service) Search(ctx context.Context, in *publicpb.SearchRequest) (*publicpb.SearchResponse, error) {
+	if err := s.ValidateSearchRequest(in); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	out, _, err := s.SearchImpl(ctx, in)
+	return out, err
+}
+
+// Upsert implements example.v1.People.Upsert.
+//
+// example.v1.People.Upsert is implemented by the UpsertHook option.
+func (s *Service) Upsert(ctx context.Context, in *publicpb.UpsertRequest) (*publicpb.UpsertResponse, error) {
+	if err := s.ValidateUpsertRequest(in); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	out, _, err := s.UpsertImpl(ctx, in)
+	return out, err
+}
+
+// CreateImpl implements example.v1.People.Create and returns the private output.
+//
+// example.v1.People.Create is delegated to example.v2.People.Create.
 func (s *Service) CreateImpl(ctx context.Context, in *publicpb.CreateRequest, mutators ...private.CreateRequestMutator) (*publicpb.CreateResponse, *privatepb.CreateResponse, error) {
 	// Set mutators for all deprecated fields
+	// example.v1.CreateRequest.first_name is deprecated; forwarded to example.private.CreateRequest.first_name via mutator.
 	mutators = append(mutators, private.SetCreateRequest_FirstName(in.FirstName))
+	// example.v1.CreateRequest.last_name is deprecated; forwarded to example.private.CreateRequest.last_name via mutator.
 	mutators = append(mutators, private.SetCreateRequest_LastName(in.LastName))
-	inNext := s.ToNextCreateRequest(in)
+	inNext, err := s.ToNextCreateRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
 	outNext, outPriv, err := s.Next.CreateImpl(ctx, inNext, mutators...)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, toPublicError(err, s.ToPublicCreateRequestFieldPath)
 	}
 
 	out, err := s.ToPublicCreateResponse(outNext, outPriv)
@@ -1516,12 +3086,19 @@ func (s *Service) CreateImpl(ctx context.Context, in *publicpb.CreateRequest, mu
 	}
 	return out, outPriv, nil
 }
+
+// GetImpl implements example.v1.People.Get and returns the private output.
+//
+// example.v1.People.Get is delegated to example.v2.People.Get.
 func (s *Service) GetImpl(ctx context.Context, in *publicpb.GetRequest, mutators ...private.FetchRequestMutator) (*publicpb.GetResponse, *privatepb.FetchResponse, error) {
 	// Set mutators for all deprecated fields
-	inNext := s.ToNextGetRequest(in)
+	inNext, err := s.ToNextGetRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
 	outNext, outPriv, err := s.Next.GetImpl(ctx, inNext, mutators...)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, toPublicError(err, s.ToPublicGetRequestFieldPath)
 	}
 
 	out, err := s.ToPublicGetResponse(outNext, outPriv)
@@ -1530,12 +3107,19 @@ func (s *Service) GetImpl(ctx context.Context, in *publicpb.GetRequest, mutators
 	}
 	return out, outPriv, nil
 }
+
+// DeleteImpl implements example.v1.People.Delete and returns the private output.
+//
+// example.v1.People.Delete is delegated to example.v2.People.Delete.
 func (s *Service) DeleteImpl(ctx context.Context, in *publicpb.DeleteRequest, mutators ...private.DeleteRequestMutator) (*publicpb.DeleteResponse, *privatepb.DeleteResponse, error) {
 	// Set mutators for all deprecated fields
-	inNext := s.ToNextDeleteRequest(in)
+	inNext, err := s.ToNextDeleteRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
 	outNext, outPriv, err := s.Next.DeleteImpl(ctx, inNext, mutators...)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, toPublicError(err, s.ToPublicDeleteRequestFieldPath)
 	}
 
 	out, err := s.ToPublicDeleteResponse(outNext, outPriv)
@@ -1544,30 +3128,48 @@ func (s *Service) DeleteImpl(ctx context.Context, in *publicpb.DeleteRequest, mu
 	}
 	return out, outPriv, nil
 }
+
+// ListImpl implements example.v1.People.List and returns the private output.
+//
+// example.v1.People.List is deprecated; delegated to example.private.People.List.
 func (s *Service) ListImpl(ctx context.Context, in *publicpb.ListRequest, mutators ...private.ListRequestMutator) (*publicpb.ListResponse, *privatepb.ListResponse, error) {
 	// Set mutators for all deprecated fields
-	inPriv := s.ToPrivateListRequest(in)
+	inPriv, err := s.ToPrivateListRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
 	for _, mutator := range mutators {
 		mutator(inPriv)
 	}
 
+	inPriv.PageSize = int32(in.Limit)
+	inPriv.PageToken = s.pageTokenCodec().Encode(int64(in.Offset))
+
 	outPriv, err := s.Private.List(ctx, inPriv)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, toPublicError(err, s.ToDeprecatedPublicListRequestFieldPath)
 	}
 
 	out, err := s.ToDeprecatedPublicListResponse(outPriv)
 	if err != nil {
 		return nil, nil, status.Errorf(codes.FailedPrecondition, "%s", err)
 	}
+
 	return out, outPriv, nil
 }
+
+// PingImpl implements example.v1.People.Ping and returns the private output.
+//
+// example.v1.People.Ping is delegated to example.v2.People.Ping.
 func (s *Service) PingImpl(ctx context.Context, in *extemptypb.Empty, mutators ...private.PingRequestMutator) (*extemptypb.Empty, *privatepb.PingResponse, error) {
 	// Set mutators for all deprecated fields
-	inNext := s.ToNextPingRequest(in)
+	inNext, err := s.ToNextPingRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
 	outNext, outPriv, err := s.Next.PingImpl(ctx, inNext, mutators...)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, toPublicError(err, s.ToPublicPingInput_ExternalEmptyFieldPath)
 	}
 
 	out, err := s.ToPublicPingOutput_ExternalEmpty(outNext, outPriv)
@@ -1576,3 +3178,137 @@ func (s *Service) PingImpl(ctx context.Context, in *extemptypb.Empty, mutators .
 	}
 	return out, outPriv, nil
 }
+
+// SearchImpl implements example.v1.People.Search and returns the private output.
+//
+// example.v1.People.Search is delegated to example.v2.People.Search.
+func (s *Service) SearchImpl(ctx context.Context, in *publicpb.SearchRequest, mutators ...private.SearchRequestMutator) (*publicpb.SearchResponse, *privatepb.SearchResponse, error) {
+	// Set mutators for all deprecated fields
+	inNext, err := s.ToNextSearchRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	inNext.PageSize = int32(in.PageSize)
+	inNext.PageToken = in.PageToken
+
+	outNext, outPriv, err := s.Next.SearchImpl(ctx, inNext, mutators...)
+	if err != nil {
+		return nil, nil, toPublicError(err, s.ToPublicSearchRequestFieldPath)
+	}
+
+	out, err := s.ToPublicSearchResponse(outNext, outPriv)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+	}
+
+	if out != nil {
+		out.NextPageToken = outNext.GetNextPageToken()
+	}
+
+	return out, outPriv, nil
+}
+
+// UpsertImpl implements example.v1.People.Upsert and returns the private output.
+//
+// example.v1.People.Upsert is implemented by the UpsertHook option.
+func (s *Service) UpsertImpl(ctx context.Context, in *publicpb.UpsertRequest, mutators ...private.UpdateRequestMutator) (*publicpb.UpsertResponse, *privatepb.UpdateResponse, error) {
+	// Set mutators for all deprecated fields
+	inPriv, err := s.ToPrivateUpdateRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	for _, mutator := range mutators {
+		mutator(inPriv)
+	}
+
+	if s.UpsertHook == nil {
+		return nil, nil, status.Error(codes.Unimplemented, "method Upsert not implemented")
+	}
+
+	outPriv, err := s.UpsertHook.Upsert(ctx, inPriv, s.Private)
+	if err != nil {
+		return nil, nil, toPublicError(err, s.ToDeprecatedPublicUpsertRequestFieldPath)
+	}
+
+	out, err := s.ToDeprecatedPublicUpsertResponse(outPriv)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+	}
+	return out, outPriv, nil
+}
+
+// fieldPathKeys are the keys of `ErrorInfo` metadata holding a field path.
+var fieldPathKeys = []string{"field"}
+
+// toPublicError rewrites the field paths found in the details of a status
+// error with `fieldPath`. Field violations of `BadRequest` details and the
+// metadata values of `ErrorInfo` details under `fieldPathKeys` are rewritten.
+// All other errors, details and metadata values are returned as is.
+func toPublicError(err error, fieldPath func(string) string) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	pb := st.Proto()
+	if len(pb.Details) == 0 {
+		return err
+	}
+
+	for i, detail := range pb.Details {
+		msg, err := detail.UnmarshalNew()
+		if err != nil {
+			continue
+		}
+
+		switch d := msg.(type) {
+		case *errdetails.BadRequest:
+			for _, violation := range d.FieldViolations {
+				violation.Field = fieldPath(violation.Field)
+			}
+		case *errdetails.ErrorInfo:
+			for _, key := range fieldPathKeys {
+				if value, ok := d.Metadata[key]; ok {
+					d.Metadata[key] = fieldPath(value)
+				}
+			}
+		default:
+			continue
+		}
+
+		value, err := anypb.New(msg)
+		if err != nil {
+			continue
+		}
+
+		pb.Details[i] = value
+	}
+
+	return status.ErrorProto(pb)
+}
+
+// splitFieldPath splits a field path such as `items[0].name` into the name of
+// the first field, its index and the remainder of the path.
+func splitFieldPath(path string) (string, string, string) {
+	name, rest := path, ""
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		name, rest = name[:i], name[i+1:]
+	}
+
+	var index string
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name, index = name[:i], name[i:]
+	}
+
+	return name, index, rest
+}
+
+// joinFieldPath reverses splitFieldPath.
+func joinFieldPath(name, index, rest string) string {
+	if rest == "" {
+		return name + index
+	}
+
+	return name + index + "." + rest
+}
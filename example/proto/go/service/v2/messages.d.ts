@@ -0,0 +1,114 @@
+// Code generated by protoc-gen-go-svc. DO NOT EDIT.
+// source: example.v2
+
+/**
+ * Person is an entry of the directory.
+ */
+export interface Person {
+  id?: string;
+  /**
+   * full_name is the first and last name of the person.
+   */
+  fullName?: string;
+  age?: string;
+  employment?: "UNSET" | "FULL_TIME" | "PART_TIME" | "UNEMPLOYED";
+  createdAt?: string;
+  updatedAt?: string;
+  hobby?: Hobby;
+  nickname?: string;
+  address?: Person_Address;
+  photo?: string;
+  previousEmployment?: "UNSET" | "FULL_TIME" | "PART_TIME" | "UNEMPLOYED";
+}
+
+export interface Person_Address {
+  city?: string;
+}
+
+export interface Hobby {
+  coding?: Coding;
+  reading?: Reading;
+  cycling?: Cycling;
+}
+
+export interface Coding {
+  language?: string;
+}
+
+export interface Reading {
+  genre?: string;
+}
+
+export interface Cycling {
+  style?: string;
+}
+
+export interface CreateRequest {
+  id?: string;
+  fullName?: string;
+  age?: string;
+  employment?: "UNSET" | "FULL_TIME" | "PART_TIME" | "UNEMPLOYED";
+  hobby?: Hobby;
+  nickname?: string;
+}
+
+export interface CreateResponse {
+  person?: Person;
+}
+
+export interface GetRequest {
+  id?: string;
+}
+
+export interface GetResponse {
+  person?: Person;
+}
+
+export interface DeleteRequest {
+  id?: string;
+}
+
+export interface DeleteResponse {
+}
+
+export interface UpdateRequest {
+  id?: string;
+  person?: Person;
+}
+
+export interface UpdateResponse {
+  person?: Person;
+}
+
+export interface BatchRequest {
+  creates?: Array<CreateRequest>;
+}
+
+export interface BatchResponse {
+  people?: Array<Person>;
+}
+
+export interface PingRequest {
+}
+
+export interface PingResponse {
+}
+
+export interface GetManyRequest {
+  requests?: Array<GetRequest>;
+}
+
+export interface GetManyResponse {
+  responses?: Array<GetResponse>;
+}
+
+export interface SearchRequest {
+  pageSize?: number;
+  pageToken?: string;
+  query?: string;
+}
+
+export interface SearchResponse {
+  people?: Array<Person>;
+  nextPageToken?: string;
+}
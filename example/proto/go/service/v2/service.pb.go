@@ -5,13 +5,26 @@ package v2
 
 import (
 	context "context"
+	base64 "encoding/base64"
 	errors "errors"
+	fmt "fmt"
+	strconv "strconv"
+	strings "strings"
+	sync "sync"
+	time "time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	is "github.com/go-ozzo/ozzo-validation/v4/is"
+	errdetails "google.golang.org/genproto/googleapis/rpc/errdetails"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	proto "google.golang.org/protobuf/proto"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	exttimestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	extwrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 
 	privatepb "github.com/dane/protoc-gen-go-svc/example/proto/go/private"
 	private "github.com/dane/protoc-gen-go-svc/example/proto/go/service/private"
@@ -20,26 +33,40 @@ import (
 
 var (
 	_ = errors.New
+	_ = fmt.Errorf
+	_ = time.Parse
 	_ = context.Background
 	_ = validation.Validate
 	_ = is.Int
 	_ = codes.OK
 	_ = status.Errorf
+	_ = proto.Merge
+	_ = strings.IndexByte
+	_ = (*sync.WaitGroup)(nil)
+	_ = base64.RawURLEncoding
+	_ = strconv.FormatInt
+	_ = (*errdetails.BadRequest)(nil)
+	_ = anypb.New
+	_ = durationpb.New
+	_ = timestamppb.New
+	_ = wrapperspb.String
 	_ = privatepb.RegisterPeopleServer
 	_ = publicpb.RegisterPeopleServer
 	_ = private.ValidatorName
 )
 
 const (
-	ConverterName = "example.v2.Converter"
-	ValidatorName = "example.v2.Validator"
+	ConverterName      = "example.v2.Converter"
+	ValidatorName      = "example.v2.Validator"
+	PageTokenCodecName = "example.v2.PageTokenCodec"
 )
 
 type Service struct {
 	Validator
 	Converter
 	publicpb.PeopleServer
-	Private *private.Service
+	Private        *private.Service
+	PageTokenCodec PageTokenCodec
 }
 
 func NewConverter() Converter {
@@ -49,76 +76,136 @@ func NewConverter() Converter {
 type Converter interface {
 	Name() string
 	ToPublicPerson(*privatepb.Person) (*publicpb.Person, error)
+	ToPublicPersonFieldPath(string) string
 	ToDeprecatedPublicPerson(*privatepb.Person) (*publicpb.Person, error)
-	ToPrivatePerson(*publicpb.Person) *privatepb.Person
+	ToDeprecatedPublicPersonFieldPath(string) string
+	ToPrivatePerson(*publicpb.Person) (*privatepb.Person, error)
+
+	ToPublicPerson_Address(*privatepb.Person_Address) (*publicpb.Person_Address, error)
+	ToPublicPerson_AddressFieldPath(string) string
+	ToDeprecatedPublicPerson_Address(*privatepb.Person_Address) (*publicpb.Person_Address, error)
+	ToDeprecatedPublicPerson_AddressFieldPath(string) string
+	ToPrivatePerson_Address(*publicpb.Person_Address) (*privatepb.Person_Address, error)
 
 	ToPublicHobby(*privatepb.Hobby) (*publicpb.Hobby, error)
+	ToPublicHobbyFieldPath(string) string
 	ToDeprecatedPublicHobby(*privatepb.Hobby) (*publicpb.Hobby, error)
-	ToPrivateHobby(*publicpb.Hobby) *privatepb.Hobby
+	ToDeprecatedPublicHobbyFieldPath(string) string
+	ToPrivateHobby(*publicpb.Hobby) (*privatepb.Hobby, error)
 
 	ToPublicCoding(*privatepb.Coding) (*publicpb.Coding, error)
+	ToPublicCodingFieldPath(string) string
 	ToDeprecatedPublicCoding(*privatepb.Coding) (*publicpb.Coding, error)
-	ToPrivateCoding(*publicpb.Coding) *privatepb.Coding
+	ToDeprecatedPublicCodingFieldPath(string) string
+	ToPrivateCoding(*publicpb.Coding) (*privatepb.Coding, error)
 
 	ToPublicReading(*privatepb.Reading) (*publicpb.Reading, error)
+	ToPublicReadingFieldPath(string) string
 	ToDeprecatedPublicReading(*privatepb.Reading) (*publicpb.Reading, error)
-	ToPrivateReading(*publicpb.Reading) *privatepb.Reading
+	ToDeprecatedPublicReadingFieldPath(string) string
+	ToPrivateReading(*publicpb.Reading) (*privatepb.Reading, error)
 
 	ToPublicCycling(*privatepb.Cycling) (*publicpb.Cycling, error)
+	ToPublicCyclingFieldPath(string) string
 	ToDeprecatedPublicCycling(*privatepb.Cycling) (*publicpb.Cycling, error)
-	ToPrivateCycling(*publicpb.Cycling) *privatepb.Cycling
+	ToDeprecatedPublicCyclingFieldPath(string) string
+	ToPrivateCycling(*publicpb.Cycling) (*privatepb.Cycling, error)
 
 	ToPublicCreateRequest(*privatepb.CreateRequest) (*publicpb.CreateRequest, error)
+	ToPublicCreateRequestFieldPath(string) string
 	ToDeprecatedPublicCreateRequest(*privatepb.CreateRequest) (*publicpb.CreateRequest, error)
-	ToPrivateCreateRequest(*publicpb.CreateRequest) *privatepb.CreateRequest
+	ToDeprecatedPublicCreateRequestFieldPath(string) string
+	ToPrivateCreateRequest(*publicpb.CreateRequest) (*privatepb.CreateRequest, error)
 
 	ToPublicCreateResponse(*privatepb.CreateResponse) (*publicpb.CreateResponse, error)
+	ToPublicCreateResponseFieldPath(string) string
 	ToDeprecatedPublicCreateResponse(*privatepb.CreateResponse) (*publicpb.CreateResponse, error)
-	ToPrivateCreateResponse(*publicpb.CreateResponse) *privatepb.CreateResponse
+	ToDeprecatedPublicCreateResponseFieldPath(string) string
+	ToPrivateCreateResponse(*publicpb.CreateResponse) (*privatepb.CreateResponse, error)
 
 	ToPublicGetRequest(*privatepb.FetchRequest) (*publicpb.GetRequest, error)
+	ToPublicGetRequestFieldPath(string) string
 	ToDeprecatedPublicGetRequest(*privatepb.FetchRequest) (*publicpb.GetRequest, error)
-	ToPrivateFetchRequest(*publicpb.GetRequest) *privatepb.FetchRequest
+	ToDeprecatedPublicGetRequestFieldPath(string) string
+	ToPrivateFetchRequest(*publicpb.GetRequest) (*privatepb.FetchRequest, error)
 
 	ToPublicGetResponse(*privatepb.FetchResponse) (*publicpb.GetResponse, error)
+	ToPublicGetResponseFieldPath(string) string
 	ToDeprecatedPublicGetResponse(*privatepb.FetchResponse) (*publicpb.GetResponse, error)
-	ToPrivateFetchResponse(*publicpb.GetResponse) *privatepb.FetchResponse
+	ToDeprecatedPublicGetResponseFieldPath(string) string
+	ToPrivateFetchResponse(*publicpb.GetResponse) (*privatepb.FetchResponse, error)
 
 	ToPublicDeleteRequest(*privatepb.DeleteRequest) (*publicpb.DeleteRequest, error)
+	ToPublicDeleteRequestFieldPath(string) string
 	ToDeprecatedPublicDeleteRequest(*privatepb.DeleteRequest) (*publicpb.DeleteRequest, error)
-	ToPrivateDeleteRequest(*publicpb.DeleteRequest) *privatepb.DeleteRequest
+	ToDeprecatedPublicDeleteRequestFieldPath(string) string
+	ToPrivateDeleteRequest(*publicpb.DeleteRequest) (*privatepb.DeleteRequest, error)
 
 	ToPublicDeleteResponse(*privatepb.DeleteResponse) (*publicpb.DeleteResponse, error)
+	ToPublicDeleteResponseFieldPath(string) string
 	ToDeprecatedPublicDeleteResponse(*privatepb.DeleteResponse) (*publicpb.DeleteResponse, error)
-	ToPrivateDeleteResponse(*publicpb.DeleteResponse) *privatepb.DeleteResponse
+	ToDeprecatedPublicDeleteResponseFieldPath(string) string
+	ToPrivateDeleteResponse(*publicpb.DeleteResponse) (*privatepb.DeleteResponse, error)
 
 	ToPublicUpdateRequest(*privatepb.UpdateRequest) (*publicpb.UpdateRequest, error)
+	ToPublicUpdateRequestFieldPath(string) string
 	ToDeprecatedPublicUpdateRequest(*privatepb.UpdateRequest) (*publicpb.UpdateRequest, error)
-	ToPrivateUpdateRequest(*publicpb.UpdateRequest) *privatepb.UpdateRequest
+	ToDeprecatedPublicUpdateRequestFieldPath(string) string
+	ToPrivateUpdateRequest(*publicpb.UpdateRequest) (*privatepb.UpdateRequest, error)
 
 	ToPublicUpdateResponse(*privatepb.UpdateResponse) (*publicpb.UpdateResponse, error)
+	ToPublicUpdateResponseFieldPath(string) string
 	ToDeprecatedPublicUpdateResponse(*privatepb.UpdateResponse) (*publicpb.UpdateResponse, error)
-	ToPrivateUpdateResponse(*publicpb.UpdateResponse) *privatepb.UpdateResponse
+	ToDeprecatedPublicUpdateResponseFieldPath(string) string
+	ToPrivateUpdateResponse(*publicpb.UpdateResponse) (*privatepb.UpdateResponse, error)
 
 	ToPublicBatchRequest(*privatepb.BatchRequest) (*publicpb.BatchRequest, error)
+	ToPublicBatchRequestFieldPath(string) string
 	ToDeprecatedPublicBatchRequest(*privatepb.BatchRequest) (*publicpb.BatchRequest, error)
-	ToPrivateBatchRequest(*publicpb.BatchRequest) *privatepb.BatchRequest
+	ToDeprecatedPublicBatchRequestFieldPath(string) string
+	ToPrivateBatchRequest(*publicpb.BatchRequest) (*privatepb.BatchRequest, error)
 
 	ToPublicBatchResponse(*privatepb.BatchResponse) (*publicpb.BatchResponse, error)
+	ToPublicBatchResponseFieldPath(string) string
 	ToDeprecatedPublicBatchResponse(*privatepb.BatchResponse) (*publicpb.BatchResponse, error)
-	ToPrivateBatchResponse(*publicpb.BatchResponse) *privatepb.BatchResponse
+	ToDeprecatedPublicBatchResponseFieldPath(string) string
+	ToPrivateBatchResponse(*publicpb.BatchResponse) (*privatepb.BatchResponse, error)
 
 	ToPublicPingRequest(*privatepb.PingRequest) (*publicpb.PingRequest, error)
+	ToPublicPingRequestFieldPath(string) string
 	ToDeprecatedPublicPingRequest(*privatepb.PingRequest) (*publicpb.PingRequest, error)
-	ToPrivatePingRequest(*publicpb.PingRequest) *privatepb.PingRequest
+	ToDeprecatedPublicPingRequestFieldPath(string) string
+	ToPrivatePingRequest(*publicpb.PingRequest) (*privatepb.PingRequest, error)
 
 	ToPublicPingResponse(*privatepb.PingResponse) (*publicpb.PingResponse, error)
+	ToPublicPingResponseFieldPath(string) string
 	ToDeprecatedPublicPingResponse(*privatepb.PingResponse) (*publicpb.PingResponse, error)
-	ToPrivatePingResponse(*publicpb.PingResponse) *privatepb.PingResponse
+	ToDeprecatedPublicPingResponseFieldPath(string) string
+	ToPrivatePingResponse(*publicpb.PingResponse) (*privatepb.PingResponse, error)
+
+	ToPublicSearchRequest(*privatepb.SearchRequest) (*publicpb.SearchRequest, error)
+	ToPublicSearchRequestFieldPath(string) string
+	ToDeprecatedPublicSearchRequest(*privatepb.SearchRequest) (*publicpb.SearchRequest, error)
+	ToDeprecatedPublicSearchRequestFieldPath(string) string
+	ToPrivateSearchRequest(*publicpb.SearchRequest) (*privatepb.SearchRequest, error)
+
+	ToPublicSearchResponse(*privatepb.SearchResponse) (*publicpb.SearchResponse, error)
+	ToPublicSearchResponseFieldPath(string) string
+	ToDeprecatedPublicSearchResponse(*privatepb.SearchResponse) (*publicpb.SearchResponse, error)
+	ToDeprecatedPublicSearchResponseFieldPath(string) string
+	ToPrivateSearchResponse(*publicpb.SearchResponse) (*privatepb.SearchResponse, error)
 
 	ToPublicExternalTimestamp(*exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error)
+	ToPublicExternalTimestampFieldPath(string) string
 	ToDeprecatedPublicExternalTimestamp(*exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error)
-	ToPrivateExternalTimestamp(*exttimestamppb.Timestamp) *exttimestamppb.Timestamp
+	ToDeprecatedPublicExternalTimestampFieldPath(string) string
+	ToPrivateExternalTimestamp(*exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error)
+
+	ToPublicExternalBytesValue(*extwrapperspb.BytesValue) (*extwrapperspb.BytesValue, error)
+	ToPublicExternalBytesValueFieldPath(string) string
+	ToDeprecatedPublicExternalBytesValue(*extwrapperspb.BytesValue) (*extwrapperspb.BytesValue, error)
+	ToDeprecatedPublicExternalBytesValueFieldPath(string) string
+	ToPrivateExternalBytesValue(*extwrapperspb.BytesValue) (*extwrapperspb.BytesValue, error)
 }
 
 type converter struct{}
@@ -127,6 +214,7 @@ func (c converter) Name() string {
 	return ConverterName
 }
 
+// ToPublicPerson converts example.private.Person to example.v2.Person.
 func (c converter) ToPublicPerson(priv *privatepb.Person) (*publicpb.Person, error) {
 	if priv == nil {
 		return nil, nil
@@ -161,9 +249,33 @@ func (c converter) ToPublicPerson(priv *privatepb.Person) (*publicpb.Person, err
 	if err != nil {
 		return nil, err
 	}
+	out.Nickname = priv.Nickname
+	out.Address, err = c.ToPublicPerson_Address(priv.Address)
+	if err != nil {
+		return nil, err
+	}
+	if priv.Photo != nil {
+		value := priv.Photo
+		out.Photo = &wrapperspb.BytesValue{Value: value}
+	}
+	if priv.PreviousEmployment != nil {
+		switch *priv.PreviousEmployment {
+		case privatepb.Person_UNDEFINED:
+			out.PreviousEmployment = publicpb.Person_UNSET.Enum()
+		case privatepb.Person_FULL_TIME:
+			out.PreviousEmployment = publicpb.Person_FULL_TIME.Enum()
+		case privatepb.Person_PART_TIME:
+			out.PreviousEmployment = publicpb.Person_PART_TIME.Enum()
+		case privatepb.Person_UNEMPLOYED:
+			out.PreviousEmployment = publicpb.Person_UNEMPLOYED.Enum()
+		default:
+			return nil, errors.New(`failed to populate field "PreviousEmployment"`)
+		}
+	}
 	return &out, err
 }
 
+// ToDeprecatedPublicPerson converts example.private.Person to example.v2.Person.
 func (c converter) ToDeprecatedPublicPerson(priv *privatepb.Person) (*publicpb.Person, error) {
 	if priv == nil {
 		return nil, nil
@@ -198,15 +310,67 @@ func (c converter) ToDeprecatedPublicPerson(priv *privatepb.Person) (*publicpb.P
 	if err != nil {
 		return nil, err
 	}
+	out.Nickname = priv.Nickname
+	out.Address, err = c.ToDeprecatedPublicPerson_Address(priv.Address)
+	if err != nil {
+		return nil, err
+	}
+	if priv.Photo != nil {
+		value := priv.Photo
+		out.Photo = &wrapperspb.BytesValue{Value: value}
+	}
+	if priv.PreviousEmployment != nil {
+		switch *priv.PreviousEmployment {
+		case privatepb.Person_UNDEFINED:
+			out.PreviousEmployment = publicpb.Person_UNSET.Enum()
+		case privatepb.Person_FULL_TIME:
+			out.PreviousEmployment = publicpb.Person_FULL_TIME.Enum()
+		case privatepb.Person_PART_TIME:
+			out.PreviousEmployment = publicpb.Person_PART_TIME.Enum()
+		case privatepb.Person_UNEMPLOYED:
+			out.PreviousEmployment = publicpb.Person_UNEMPLOYED.Enum()
+		default:
+			return nil, errors.New(`failed to populate field "PreviousEmployment"`)
+		}
+	}
 	return &out, err
 }
 
-func (c converter) ToPrivatePerson(in *publicpb.Person) *privatepb.Person {
+func (c converter) ToPublicPersonFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "hobby":
+		return joinFieldPath("hobby", index, c.ToPublicHobbyFieldPath(rest))
+	case "address":
+		return joinFieldPath("address", index, c.ToPublicPerson_AddressFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicPersonFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "hobby":
+		return joinFieldPath("hobby", index, c.ToDeprecatedPublicHobbyFieldPath(rest))
+	case "address":
+		return joinFieldPath("address", index, c.ToDeprecatedPublicPerson_AddressFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivatePerson converts example.v2.Person to example.private.Person.
+//
+// Person is an entry of the directory.
+func (c converter) ToPrivatePerson(in *publicpb.Person) (*privatepb.Person, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.Person
+	var err error
+
 	out.Id = in.Id
 	out.FullName = in.FullName
 	out.Age = in.Age
@@ -222,10 +386,96 @@ func (c converter) ToPrivatePerson(in *publicpb.Person) *privatepb.Person {
 	}
 	out.CreatedAt = in.CreatedAt
 	out.UpdatedAt = in.UpdatedAt
-	out.Hobby = c.ToPrivateHobby(in.Hobby)
-	return &out
+	out.Hobby, err = c.ToPrivateHobby(in.Hobby)
+	if err != nil {
+		return nil, err
+	}
+	out.Nickname = in.Nickname
+	out.Address, err = c.ToPrivatePerson_Address(in.Address)
+	if err != nil {
+		return nil, err
+	}
+	if in.Photo != nil {
+		value := in.Photo.Value
+		// An empty value is present, so it must not be nil.
+		if value == nil {
+			value = []byte{}
+		}
+		out.Photo = value
+	}
+	if in.PreviousEmployment != nil {
+		switch *in.PreviousEmployment {
+		case publicpb.Person_UNSET:
+			out.PreviousEmployment = privatepb.Person_UNDEFINED.Enum()
+		case publicpb.Person_FULL_TIME:
+			out.PreviousEmployment = privatepb.Person_FULL_TIME.Enum()
+		case publicpb.Person_PART_TIME:
+			out.PreviousEmployment = privatepb.Person_PART_TIME.Enum()
+		case publicpb.Person_UNEMPLOYED:
+			out.PreviousEmployment = privatepb.Person_UNEMPLOYED.Enum()
+		}
+	}
+	return &out, err
+}
+
+// ToPublicPerson_Address converts example.private.Person.Address to example.v2.Person.Address.
+func (c converter) ToPublicPerson_Address(priv *privatepb.Person_Address) (*publicpb.Person_Address, error) {
+	if priv == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.Person_Address
+	var err error
+
+	out.City = priv.City
+	return &out, err
+}
+
+// ToDeprecatedPublicPerson_Address converts example.private.Person.Address to example.v2.Person.Address.
+func (c converter) ToDeprecatedPublicPerson_Address(priv *privatepb.Person_Address) (*publicpb.Person_Address, error) {
+	if priv == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.Person_Address
+	var err error
+
+	out.City = priv.City
+	return &out, err
 }
 
+func (c converter) ToPublicPerson_AddressFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicPerson_AddressFieldPath(path string) string {
+	return path
+}
+
+// ToPrivatePerson_Address converts example.v2.Person.Address to example.private.Person.Address.
+func (c converter) ToPrivatePerson_Address(in *publicpb.Person_Address) (*privatepb.Person_Address, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out privatepb.Person_Address
+	var err error
+
+	out.City = in.City
+	return &out, err
+}
+
+// ToPublicHobby converts example.private.Hobby to example.v2.Hobby.
 func (c converter) ToPublicHobby(priv *privatepb.Hobby) (*publicpb.Hobby, error) {
 	if priv == nil {
 		return nil, nil
@@ -268,6 +518,7 @@ func (c converter) ToPublicHobby(priv *privatepb.Hobby) (*publicpb.Hobby, error)
 	return &out, err
 }
 
+// ToDeprecatedPublicHobby converts example.private.Hobby to example.v2.Hobby.
 func (c converter) ToDeprecatedPublicHobby(priv *privatepb.Hobby) (*publicpb.Hobby, error) {
 	if priv == nil {
 		return nil, nil
@@ -310,29 +561,73 @@ func (c converter) ToDeprecatedPublicHobby(priv *privatepb.Hobby) (*publicpb.Hob
 	return &out, err
 }
 
-func (c converter) ToPrivateHobby(in *publicpb.Hobby) *privatepb.Hobby {
+func (c converter) ToPublicHobbyFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "coding":
+		return joinFieldPath("coding", index, c.ToPublicCodingFieldPath(rest))
+	case "reading":
+		return joinFieldPath("reading", index, c.ToPublicReadingFieldPath(rest))
+	case "cycling":
+		return joinFieldPath("cycling", index, c.ToPublicCyclingFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicHobbyFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "coding":
+		return joinFieldPath("coding", index, c.ToDeprecatedPublicCodingFieldPath(rest))
+	case "reading":
+		return joinFieldPath("reading", index, c.ToDeprecatedPublicReadingFieldPath(rest))
+	case "cycling":
+		return joinFieldPath("cycling", index, c.ToDeprecatedPublicCyclingFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateHobby converts example.v2.Hobby to example.private.Hobby.
+func (c converter) ToPrivateHobby(in *publicpb.Hobby) (*privatepb.Hobby, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.Hobby
+	var err error
+
 	switch in.Type.(type) {
 	case *publicpb.Hobby_Coding:
+		value, err := c.ToPrivateCoding(in.GetCoding())
+		if err != nil {
+			return nil, err
+		}
 		out.Type = &privatepb.Hobby_Coding{
-			Coding: c.ToPrivateCoding(in.GetCoding()),
+			Coding: value,
 		}
 	case *publicpb.Hobby_Reading:
+		value, err := c.ToPrivateReading(in.GetReading())
+		if err != nil {
+			return nil, err
+		}
 		out.Type = &privatepb.Hobby_Reading{
-			Reading: c.ToPrivateReading(in.GetReading()),
+			Reading: value,
 		}
 	case *publicpb.Hobby_Cycling:
+		value, err := c.ToPrivateCycling(in.GetCycling())
+		if err != nil {
+			return nil, err
+		}
 		out.Type = &privatepb.Hobby_Cycling{
-			Cycling: c.ToPrivateCycling(in.GetCycling()),
+			Cycling: value,
 		}
 	}
-	return &out
+	return &out, err
 }
 
+// ToPublicCoding converts example.private.Coding to example.v2.Coding.
 func (c converter) ToPublicCoding(priv *privatepb.Coding) (*publicpb.Coding, error) {
 	if priv == nil {
 		return nil, nil
@@ -350,6 +645,7 @@ func (c converter) ToPublicCoding(priv *privatepb.Coding) (*publicpb.Coding, err
 	return &out, err
 }
 
+// ToDeprecatedPublicCoding converts example.private.Coding to example.v2.Coding.
 func (c converter) ToDeprecatedPublicCoding(priv *privatepb.Coding) (*publicpb.Coding, error) {
 	if priv == nil {
 		return nil, nil
@@ -367,16 +663,28 @@ func (c converter) ToDeprecatedPublicCoding(priv *privatepb.Coding) (*publicpb.C
 	return &out, err
 }
 
-func (c converter) ToPrivateCoding(in *publicpb.Coding) *privatepb.Coding {
+func (c converter) ToPublicCodingFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicCodingFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateCoding converts example.v2.Coding to example.private.Coding.
+func (c converter) ToPrivateCoding(in *publicpb.Coding) (*privatepb.Coding, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.Coding
+	var err error
+
 	out.Language = in.Language
-	return &out
+	return &out, err
 }
 
+// ToPublicReading converts example.private.Reading to example.v2.Reading.
 func (c converter) ToPublicReading(priv *privatepb.Reading) (*publicpb.Reading, error) {
 	if priv == nil {
 		return nil, nil
@@ -394,6 +702,7 @@ func (c converter) ToPublicReading(priv *privatepb.Reading) (*publicpb.Reading,
 	return &out, err
 }
 
+// ToDeprecatedPublicReading converts example.private.Reading to example.v2.Reading.
 func (c converter) ToDeprecatedPublicReading(priv *privatepb.Reading) (*publicpb.Reading, error) {
 	if priv == nil {
 		return nil, nil
@@ -411,16 +720,28 @@ func (c converter) ToDeprecatedPublicReading(priv *privatepb.Reading) (*publicpb
 	return &out, err
 }
 
-func (c converter) ToPrivateReading(in *publicpb.Reading) *privatepb.Reading {
+func (c converter) ToPublicReadingFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicReadingFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateReading converts example.v2.Reading to example.private.Reading.
+func (c converter) ToPrivateReading(in *publicpb.Reading) (*privatepb.Reading, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.Reading
+	var err error
+
 	out.Genre = in.Genre
-	return &out
+	return &out, err
 }
 
+// ToPublicCycling converts example.private.Cycling to example.v2.Cycling.
 func (c converter) ToPublicCycling(priv *privatepb.Cycling) (*publicpb.Cycling, error) {
 	if priv == nil {
 		return nil, nil
@@ -438,6 +759,7 @@ func (c converter) ToPublicCycling(priv *privatepb.Cycling) (*publicpb.Cycling,
 	return &out, err
 }
 
+// ToDeprecatedPublicCycling converts example.private.Cycling to example.v2.Cycling.
 func (c converter) ToDeprecatedPublicCycling(priv *privatepb.Cycling) (*publicpb.Cycling, error) {
 	if priv == nil {
 		return nil, nil
@@ -455,16 +777,28 @@ func (c converter) ToDeprecatedPublicCycling(priv *privatepb.Cycling) (*publicpb
 	return &out, err
 }
 
-func (c converter) ToPrivateCycling(in *publicpb.Cycling) *privatepb.Cycling {
+func (c converter) ToPublicCyclingFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicCyclingFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateCycling converts example.v2.Cycling to example.private.Cycling.
+func (c converter) ToPrivateCycling(in *publicpb.Cycling) (*privatepb.Cycling, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.Cycling
+	var err error
+
 	out.Style = in.Style
-	return &out
+	return &out, err
 }
 
+// ToPublicCreateRequest converts example.private.CreateRequest to example.v2.CreateRequest.
 func (c converter) ToPublicCreateRequest(priv *privatepb.CreateRequest) (*publicpb.CreateRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -497,9 +831,11 @@ func (c converter) ToPublicCreateRequest(priv *privatepb.CreateRequest) (*public
 	if err != nil {
 		return nil, err
 	}
+	out.Nickname = priv.Nickname
 	return &out, err
 }
 
+// ToDeprecatedPublicCreateRequest converts example.private.CreateRequest to example.v2.CreateRequest.
 func (c converter) ToDeprecatedPublicCreateRequest(priv *privatepb.CreateRequest) (*publicpb.CreateRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -532,15 +868,39 @@ func (c converter) ToDeprecatedPublicCreateRequest(priv *privatepb.CreateRequest
 	if err != nil {
 		return nil, err
 	}
+	out.Nickname = priv.Nickname
 	return &out, err
 }
 
-func (c converter) ToPrivateCreateRequest(in *publicpb.CreateRequest) *privatepb.CreateRequest {
+func (c converter) ToPublicCreateRequestFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "hobby":
+		return joinFieldPath("hobby", index, c.ToPublicHobbyFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicCreateRequestFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "hobby":
+		return joinFieldPath("hobby", index, c.ToDeprecatedPublicHobbyFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateCreateRequest converts example.v2.CreateRequest to example.private.CreateRequest.
+func (c converter) ToPrivateCreateRequest(in *publicpb.CreateRequest) (*privatepb.CreateRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.CreateRequest
+	var err error
+
 	out.Id = in.Id
 	out.FullName = in.FullName
 	out.Age = in.Age
@@ -554,10 +914,15 @@ func (c converter) ToPrivateCreateRequest(in *publicpb.CreateRequest) *privatepb
 	case publicpb.Person_UNEMPLOYED:
 		out.Employment = privatepb.Person_UNEMPLOYED
 	}
-	out.Hobby = c.ToPrivateHobby(in.Hobby)
-	return &out
+	out.Hobby, err = c.ToPrivateHobby(in.Hobby)
+	if err != nil {
+		return nil, err
+	}
+	out.Nickname = in.Nickname
+	return &out, err
 }
 
+// ToPublicCreateResponse converts example.private.CreateResponse to example.v2.CreateResponse.
 func (c converter) ToPublicCreateResponse(priv *privatepb.CreateResponse) (*publicpb.CreateResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -578,6 +943,7 @@ func (c converter) ToPublicCreateResponse(priv *privatepb.CreateResponse) (*publ
 	return &out, err
 }
 
+// ToDeprecatedPublicCreateResponse converts example.private.CreateResponse to example.v2.CreateResponse.
 func (c converter) ToDeprecatedPublicCreateResponse(priv *privatepb.CreateResponse) (*publicpb.CreateResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -598,16 +964,43 @@ func (c converter) ToDeprecatedPublicCreateResponse(priv *privatepb.CreateRespon
 	return &out, err
 }
 
-func (c converter) ToPrivateCreateResponse(in *publicpb.CreateResponse) *privatepb.CreateResponse {
+func (c converter) ToPublicCreateResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicCreateResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToDeprecatedPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateCreateResponse converts example.v2.CreateResponse to example.private.CreateResponse.
+func (c converter) ToPrivateCreateResponse(in *publicpb.CreateResponse) (*privatepb.CreateResponse, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.CreateResponse
-	out.Person = c.ToPrivatePerson(in.Person)
-	return &out
+	var err error
+
+	out.Person, err = c.ToPrivatePerson(in.Person)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
+// ToPublicGetRequest converts example.private.FetchRequest to example.v2.GetRequest.
 func (c converter) ToPublicGetRequest(priv *privatepb.FetchRequest) (*publicpb.GetRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -625,6 +1018,7 @@ func (c converter) ToPublicGetRequest(priv *privatepb.FetchRequest) (*publicpb.G
 	return &out, err
 }
 
+// ToDeprecatedPublicGetRequest converts example.private.FetchRequest to example.v2.GetRequest.
 func (c converter) ToDeprecatedPublicGetRequest(priv *privatepb.FetchRequest) (*publicpb.GetRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -642,16 +1036,28 @@ func (c converter) ToDeprecatedPublicGetRequest(priv *privatepb.FetchRequest) (*
 	return &out, err
 }
 
-func (c converter) ToPrivateFetchRequest(in *publicpb.GetRequest) *privatepb.FetchRequest {
+func (c converter) ToPublicGetRequestFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicGetRequestFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateFetchRequest converts example.v2.GetRequest to example.private.FetchRequest.
+func (c converter) ToPrivateFetchRequest(in *publicpb.GetRequest) (*privatepb.FetchRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.FetchRequest
+	var err error
+
 	out.Id = in.Id
-	return &out
+	return &out, err
 }
 
+// ToPublicGetResponse converts example.private.FetchResponse to example.v2.GetResponse.
 func (c converter) ToPublicGetResponse(priv *privatepb.FetchResponse) (*publicpb.GetResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -672,6 +1078,7 @@ func (c converter) ToPublicGetResponse(priv *privatepb.FetchResponse) (*publicpb
 	return &out, err
 }
 
+// ToDeprecatedPublicGetResponse converts example.private.FetchResponse to example.v2.GetResponse.
 func (c converter) ToDeprecatedPublicGetResponse(priv *privatepb.FetchResponse) (*publicpb.GetResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -692,16 +1099,43 @@ func (c converter) ToDeprecatedPublicGetResponse(priv *privatepb.FetchResponse)
 	return &out, err
 }
 
-func (c converter) ToPrivateFetchResponse(in *publicpb.GetResponse) *privatepb.FetchResponse {
+func (c converter) ToPublicGetResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicGetResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToDeprecatedPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateFetchResponse converts example.v2.GetResponse to example.private.FetchResponse.
+func (c converter) ToPrivateFetchResponse(in *publicpb.GetResponse) (*privatepb.FetchResponse, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.FetchResponse
-	out.Person = c.ToPrivatePerson(in.Person)
-	return &out
+	var err error
+
+	out.Person, err = c.ToPrivatePerson(in.Person)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
+// ToPublicDeleteRequest converts example.private.DeleteRequest to example.v2.DeleteRequest.
 func (c converter) ToPublicDeleteRequest(priv *privatepb.DeleteRequest) (*publicpb.DeleteRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -719,6 +1153,7 @@ func (c converter) ToPublicDeleteRequest(priv *privatepb.DeleteRequest) (*public
 	return &out, err
 }
 
+// ToDeprecatedPublicDeleteRequest converts example.private.DeleteRequest to example.v2.DeleteRequest.
 func (c converter) ToDeprecatedPublicDeleteRequest(priv *privatepb.DeleteRequest) (*publicpb.DeleteRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -736,16 +1171,28 @@ func (c converter) ToDeprecatedPublicDeleteRequest(priv *privatepb.DeleteRequest
 	return &out, err
 }
 
-func (c converter) ToPrivateDeleteRequest(in *publicpb.DeleteRequest) *privatepb.DeleteRequest {
+func (c converter) ToPublicDeleteRequestFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicDeleteRequestFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateDeleteRequest converts example.v2.DeleteRequest to example.private.DeleteRequest.
+func (c converter) ToPrivateDeleteRequest(in *publicpb.DeleteRequest) (*privatepb.DeleteRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.DeleteRequest
+	var err error
+
 	out.Id = in.Id
-	return &out
+	return &out, err
 }
 
+// ToPublicDeleteResponse converts example.private.DeleteResponse to example.v2.DeleteResponse.
 func (c converter) ToPublicDeleteResponse(priv *privatepb.DeleteResponse) (*publicpb.DeleteResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -762,6 +1209,7 @@ func (c converter) ToPublicDeleteResponse(priv *privatepb.DeleteResponse) (*publ
 	return &out, err
 }
 
+// ToDeprecatedPublicDeleteResponse converts example.private.DeleteResponse to example.v2.DeleteResponse.
 func (c converter) ToDeprecatedPublicDeleteResponse(priv *privatepb.DeleteResponse) (*publicpb.DeleteResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -778,15 +1226,27 @@ func (c converter) ToDeprecatedPublicDeleteResponse(priv *privatepb.DeleteRespon
 	return &out, err
 }
 
-func (c converter) ToPrivateDeleteResponse(in *publicpb.DeleteResponse) *privatepb.DeleteResponse {
+func (c converter) ToPublicDeleteResponseFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicDeleteResponseFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateDeleteResponse converts example.v2.DeleteResponse to example.private.DeleteResponse.
+func (c converter) ToPrivateDeleteResponse(in *publicpb.DeleteResponse) (*privatepb.DeleteResponse, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.DeleteResponse
-	return &out
+	var err error
+
+	return &out, err
 }
 
+// ToPublicUpdateRequest converts example.private.UpdateRequest to example.v2.UpdateRequest.
 func (c converter) ToPublicUpdateRequest(priv *privatepb.UpdateRequest) (*publicpb.UpdateRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -808,6 +1268,7 @@ func (c converter) ToPublicUpdateRequest(priv *privatepb.UpdateRequest) (*public
 	return &out, err
 }
 
+// ToDeprecatedPublicUpdateRequest converts example.private.UpdateRequest to example.v2.UpdateRequest.
 func (c converter) ToDeprecatedPublicUpdateRequest(priv *privatepb.UpdateRequest) (*publicpb.UpdateRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -829,17 +1290,44 @@ func (c converter) ToDeprecatedPublicUpdateRequest(priv *privatepb.UpdateRequest
 	return &out, err
 }
 
-func (c converter) ToPrivateUpdateRequest(in *publicpb.UpdateRequest) *privatepb.UpdateRequest {
+func (c converter) ToPublicUpdateRequestFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicUpdateRequestFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToDeprecatedPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateUpdateRequest converts example.v2.UpdateRequest to example.private.UpdateRequest.
+func (c converter) ToPrivateUpdateRequest(in *publicpb.UpdateRequest) (*privatepb.UpdateRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.UpdateRequest
+	var err error
+
 	out.Id = in.Id
-	out.Person = c.ToPrivatePerson(in.Person)
-	return &out
+	out.Person, err = c.ToPrivatePerson(in.Person)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
+// ToPublicUpdateResponse converts example.private.UpdateResponse to example.v2.UpdateResponse.
 func (c converter) ToPublicUpdateResponse(priv *privatepb.UpdateResponse) (*publicpb.UpdateResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -860,6 +1348,7 @@ func (c converter) ToPublicUpdateResponse(priv *privatepb.UpdateResponse) (*publ
 	return &out, err
 }
 
+// ToDeprecatedPublicUpdateResponse converts example.private.UpdateResponse to example.v2.UpdateResponse.
 func (c converter) ToDeprecatedPublicUpdateResponse(priv *privatepb.UpdateResponse) (*publicpb.UpdateResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -880,16 +1369,43 @@ func (c converter) ToDeprecatedPublicUpdateResponse(priv *privatepb.UpdateRespon
 	return &out, err
 }
 
-func (c converter) ToPrivateUpdateResponse(in *publicpb.UpdateResponse) *privatepb.UpdateResponse {
+func (c converter) ToPublicUpdateResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicUpdateResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "person":
+		return joinFieldPath("person", index, c.ToDeprecatedPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateUpdateResponse converts example.v2.UpdateResponse to example.private.UpdateResponse.
+func (c converter) ToPrivateUpdateResponse(in *publicpb.UpdateResponse) (*privatepb.UpdateResponse, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.UpdateResponse
-	out.Person = c.ToPrivatePerson(in.Person)
-	return &out
+	var err error
+
+	out.Person, err = c.ToPrivatePerson(in.Person)
+	if err != nil {
+		return nil, err
+	}
+	return &out, err
 }
 
+// ToPublicBatchRequest converts example.private.BatchRequest to example.v2.BatchRequest.
 func (c converter) ToPublicBatchRequest(priv *privatepb.BatchRequest) (*publicpb.BatchRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -913,6 +1429,7 @@ func (c converter) ToPublicBatchRequest(priv *privatepb.BatchRequest) (*publicpb
 	return &out, err
 }
 
+// ToDeprecatedPublicBatchRequest converts example.private.BatchRequest to example.v2.BatchRequest.
 func (c converter) ToDeprecatedPublicBatchRequest(priv *privatepb.BatchRequest) (*publicpb.BatchRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -936,19 +1453,46 @@ func (c converter) ToDeprecatedPublicBatchRequest(priv *privatepb.BatchRequest)
 	return &out, err
 }
 
-func (c converter) ToPrivateBatchRequest(in *publicpb.BatchRequest) *privatepb.BatchRequest {
+func (c converter) ToPublicBatchRequestFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "creates":
+		return joinFieldPath("creates", index, c.ToPublicCreateRequestFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicBatchRequestFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "creates":
+		return joinFieldPath("creates", index, c.ToDeprecatedPublicCreateRequestFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateBatchRequest converts example.v2.BatchRequest to example.private.BatchRequest.
+func (c converter) ToPrivateBatchRequest(in *publicpb.BatchRequest) (*privatepb.BatchRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.BatchRequest
+	var err error
+
 	for _, item := range in.Creates {
-		conv := c.ToPrivateCreateRequest(item)
+		conv, err := c.ToPrivateCreateRequest(item)
+		if err != nil {
+			return nil, err
+		}
 		out.Creates = append(out.Creates, conv)
 	}
-	return &out
+	return &out, err
 }
 
+// ToPublicBatchResponse converts example.private.BatchResponse to example.v2.BatchResponse.
 func (c converter) ToPublicBatchResponse(priv *privatepb.BatchResponse) (*publicpb.BatchResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -972,6 +1516,7 @@ func (c converter) ToPublicBatchResponse(priv *privatepb.BatchResponse) (*public
 	return &out, err
 }
 
+// ToDeprecatedPublicBatchResponse converts example.private.BatchResponse to example.v2.BatchResponse.
 func (c converter) ToDeprecatedPublicBatchResponse(priv *privatepb.BatchResponse) (*publicpb.BatchResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -995,19 +1540,46 @@ func (c converter) ToDeprecatedPublicBatchResponse(priv *privatepb.BatchResponse
 	return &out, err
 }
 
-func (c converter) ToPrivateBatchResponse(in *publicpb.BatchResponse) *privatepb.BatchResponse {
-	if in == nil {
-		return nil
+func (c converter) ToPublicBatchResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "people":
+		return joinFieldPath("people", index, c.ToPublicPersonFieldPath(rest))
 	}
 
-	var out privatepb.BatchResponse
-	for _, item := range in.People {
-		conv := c.ToPrivatePerson(item)
+	return path
+}
+
+func (c converter) ToDeprecatedPublicBatchResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "people":
+		return joinFieldPath("people", index, c.ToDeprecatedPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateBatchResponse converts example.v2.BatchResponse to example.private.BatchResponse.
+func (c converter) ToPrivateBatchResponse(in *publicpb.BatchResponse) (*privatepb.BatchResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out privatepb.BatchResponse
+	var err error
+
+	for _, item := range in.People {
+		conv, err := c.ToPrivatePerson(item)
+		if err != nil {
+			return nil, err
+		}
 		out.People = append(out.People, conv)
 	}
-	return &out
+	return &out, err
 }
 
+// ToPublicPingRequest converts example.private.PingRequest to example.v2.PingRequest.
 func (c converter) ToPublicPingRequest(priv *privatepb.PingRequest) (*publicpb.PingRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -1024,6 +1596,7 @@ func (c converter) ToPublicPingRequest(priv *privatepb.PingRequest) (*publicpb.P
 	return &out, err
 }
 
+// ToDeprecatedPublicPingRequest converts example.private.PingRequest to example.v2.PingRequest.
 func (c converter) ToDeprecatedPublicPingRequest(priv *privatepb.PingRequest) (*publicpb.PingRequest, error) {
 	if priv == nil {
 		return nil, nil
@@ -1040,15 +1613,27 @@ func (c converter) ToDeprecatedPublicPingRequest(priv *privatepb.PingRequest) (*
 	return &out, err
 }
 
-func (c converter) ToPrivatePingRequest(in *publicpb.PingRequest) *privatepb.PingRequest {
+func (c converter) ToPublicPingRequestFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicPingRequestFieldPath(path string) string {
+	return path
+}
+
+// ToPrivatePingRequest converts example.v2.PingRequest to example.private.PingRequest.
+func (c converter) ToPrivatePingRequest(in *publicpb.PingRequest) (*privatepb.PingRequest, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.PingRequest
-	return &out
+	var err error
+
+	return &out, err
 }
 
+// ToPublicPingResponse converts example.private.PingResponse to example.v2.PingResponse.
 func (c converter) ToPublicPingResponse(priv *privatepb.PingResponse) (*publicpb.PingResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -1065,6 +1650,7 @@ func (c converter) ToPublicPingResponse(priv *privatepb.PingResponse) (*publicpb
 	return &out, err
 }
 
+// ToDeprecatedPublicPingResponse converts example.private.PingResponse to example.v2.PingResponse.
 func (c converter) ToDeprecatedPublicPingResponse(priv *privatepb.PingResponse) (*publicpb.PingResponse, error) {
 	if priv == nil {
 		return nil, nil
@@ -1081,25 +1667,271 @@ func (c converter) ToDeprecatedPublicPingResponse(priv *privatepb.PingResponse)
 	return &out, err
 }
 
-func (c converter) ToPrivatePingResponse(in *publicpb.PingResponse) *privatepb.PingResponse {
+func (c converter) ToPublicPingResponseFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicPingResponseFieldPath(path string) string {
+	return path
+}
+
+// ToPrivatePingResponse converts example.v2.PingResponse to example.private.PingResponse.
+func (c converter) ToPrivatePingResponse(in *publicpb.PingResponse) (*privatepb.PingResponse, error) {
 	if in == nil {
-		return nil
+		return nil, nil
 	}
 
 	var out privatepb.PingResponse
-	return &out
+	var err error
+
+	return &out, err
+}
+
+// ToPublicSearchRequest converts example.private.SearchRequest to example.v2.SearchRequest.
+func (c converter) ToPublicSearchRequest(priv *privatepb.SearchRequest) (*publicpb.SearchRequest, error) {
+	if priv == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.SearchRequest
+	var err error
+
+	out.Query = priv.Query
+	return &out, err
+}
+
+// ToDeprecatedPublicSearchRequest converts example.private.SearchRequest to example.v2.SearchRequest.
+func (c converter) ToDeprecatedPublicSearchRequest(priv *privatepb.SearchRequest) (*publicpb.SearchRequest, error) {
+	if priv == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.SearchRequest
+	var err error
+
+	out.Query = priv.Query
+	return &out, err
+}
+
+func (c converter) ToPublicSearchRequestFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicSearchRequestFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateSearchRequest converts example.v2.SearchRequest to example.private.SearchRequest.
+func (c converter) ToPrivateSearchRequest(in *publicpb.SearchRequest) (*privatepb.SearchRequest, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out privatepb.SearchRequest
+	var err error
+
+	out.Query = in.Query
+	return &out, err
 }
 
+// ToPublicSearchResponse converts example.private.SearchResponse to example.v2.SearchResponse.
+func (c converter) ToPublicSearchResponse(priv *privatepb.SearchResponse) (*publicpb.SearchResponse, error) {
+	if priv == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.SearchResponse
+	var err error
+
+	for _, item := range priv.People {
+		conv, err := c.ToPublicPerson(item)
+		if err != nil {
+			return nil, err
+		}
+		out.People = append(out.People, conv)
+	}
+	return &out, err
+}
+
+// ToDeprecatedPublicSearchResponse converts example.private.SearchResponse to example.v2.SearchResponse.
+func (c converter) ToDeprecatedPublicSearchResponse(priv *privatepb.SearchResponse) (*publicpb.SearchResponse, error) {
+	if priv == nil {
+		return nil, nil
+	}
+
+	required := make(validation.Errors)
+	if err := required.Filter(); err != nil {
+		return nil, err
+	}
+
+	var out publicpb.SearchResponse
+	var err error
+
+	for _, item := range priv.People {
+		conv, err := c.ToDeprecatedPublicPerson(item)
+		if err != nil {
+			return nil, err
+		}
+		out.People = append(out.People, conv)
+	}
+	return &out, err
+}
+
+func (c converter) ToPublicSearchResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "people":
+		return joinFieldPath("people", index, c.ToPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+func (c converter) ToDeprecatedPublicSearchResponseFieldPath(path string) string {
+	name, index, rest := splitFieldPath(path)
+	switch name {
+	case "people":
+		return joinFieldPath("people", index, c.ToDeprecatedPublicPersonFieldPath(rest))
+	}
+
+	return path
+}
+
+// ToPrivateSearchResponse converts example.v2.SearchResponse to example.private.SearchResponse.
+func (c converter) ToPrivateSearchResponse(in *publicpb.SearchResponse) (*privatepb.SearchResponse, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	var out privatepb.SearchResponse
+	var err error
+
+	for _, item := range in.People {
+		conv, err := c.ToPrivatePerson(item)
+		if err != nil {
+			return nil, err
+		}
+		out.People = append(out.People, conv)
+	}
+	return &out, err
+}
+
+// ToPublicExternalTimestamp converts google.protobuf.Timestamp to google.protobuf.Timestamp.
 func (c converter) ToPublicExternalTimestamp(priv *exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error) {
 	return priv, nil
 }
 
+// ToDeprecatedPublicExternalTimestamp converts google.protobuf.Timestamp to google.protobuf.Timestamp.
 func (c converter) ToDeprecatedPublicExternalTimestamp(priv *exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error) {
 	return priv, nil
 }
 
-func (c converter) ToPrivateExternalTimestamp(in *exttimestamppb.Timestamp) *exttimestamppb.Timestamp {
-	return in
+func (c converter) ToPublicExternalTimestampFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicExternalTimestampFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateExternalTimestamp converts google.protobuf.Timestamp to google.protobuf.Timestamp.
+func (c converter) ToPrivateExternalTimestamp(in *exttimestamppb.Timestamp) (*exttimestamppb.Timestamp, error) {
+	return in, nil
+}
+
+// ToPublicExternalBytesValue converts google.protobuf.BytesValue to google.protobuf.BytesValue.
+func (c converter) ToPublicExternalBytesValue(priv *extwrapperspb.BytesValue) (*extwrapperspb.BytesValue, error) {
+	return priv, nil
+}
+
+// ToDeprecatedPublicExternalBytesValue converts google.protobuf.BytesValue to google.protobuf.BytesValue.
+func (c converter) ToDeprecatedPublicExternalBytesValue(priv *extwrapperspb.BytesValue) (*extwrapperspb.BytesValue, error) {
+	return priv, nil
+}
+
+func (c converter) ToPublicExternalBytesValueFieldPath(path string) string {
+	return path
+}
+
+func (c converter) ToDeprecatedPublicExternalBytesValueFieldPath(path string) string {
+	return path
+}
+
+// ToPrivateExternalBytesValue converts google.protobuf.BytesValue to google.protobuf.BytesValue.
+func (c converter) ToPrivateExternalBytesValue(in *extwrapperspb.BytesValue) (*extwrapperspb.BytesValue, error) {
+	return in, nil
+}
+
+func NewPageTokenCodec() PageTokenCodec {
+	return pageTokenCodec{}
+}
+
+// PageTokenCodec converts between offsets and page tokens when a paginated
+// method calls a method with a different pagination style.
+type PageTokenCodec interface {
+	Name() string
+	Encode(int64) string
+	Decode(string) (int64, error)
+}
+
+// pageTokenCodec returns the page token codec of the service, or the default
+// codec when the service was not created by `RegisterServer`.
+func (s *Service) pageTokenCodec() PageTokenCodec {
+	if s.PageTokenCodec == nil {
+		return NewPageTokenCodec()
+	}
+
+	return s.PageTokenCodec
+}
+
+type pageTokenCodec struct{}
+
+func (c pageTokenCodec) Name() string {
+	return PageTokenCodecName
+}
+
+// Encode returns the offset as a base64 encoded page token. An empty page
+// token is returned for the first page.
+func (c pageTokenCodec) Encode(offset int64) string {
+	if offset <= 0 {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(offset, 10)))
+}
+
+// Decode returns the offset of a page token created by `Encode`.
+func (c pageTokenCodec) Decode(token string) (int64, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	value, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.New("invalid page token")
+	}
+
+	offset, err := strconv.ParseInt(string(value), 10, 64)
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid page token")
+	}
+
+	return offset, nil
 }
 
 func NewValidator() Validator {
@@ -1110,6 +1942,8 @@ type Validator interface {
 	Name() string
 	ValidatePerson(*publicpb.Person) error
 	ByPerson(interface{}) error
+	ValidatePerson_Address(*publicpb.Person_Address) error
+	ByPerson_Address(interface{}) error
 	ValidateHobby(*publicpb.Hobby) error
 	ByHobby(interface{}) error
 	ValidateCoding(*publicpb.Coding) error
@@ -1142,8 +1976,18 @@ type Validator interface {
 	ByPingRequest(interface{}) error
 	ValidatePingResponse(*publicpb.PingResponse) error
 	ByPingResponse(interface{}) error
+	ValidateGetManyRequest(*publicpb.GetManyRequest) error
+	ByGetManyRequest(interface{}) error
+	ValidateGetManyResponse(*publicpb.GetManyResponse) error
+	ByGetManyResponse(interface{}) error
+	ValidateSearchRequest(*publicpb.SearchRequest) error
+	BySearchRequest(interface{}) error
+	ValidateSearchResponse(*publicpb.SearchResponse) error
+	BySearchResponse(interface{}) error
 	ValidateExternalTimestamp(*exttimestamppb.Timestamp) error
 	ByExternalTimestamp(interface{}) error
+	ValidateExternalBytesValue(*extwrapperspb.BytesValue) error
+	ByExternalBytesValue(interface{}) error
 }
 
 type validator struct{}
@@ -1152,6 +1996,7 @@ func (v validator) Name() string {
 	return ValidatorName
 }
 
+// ValidatePerson validates example.v2.Person.
 func (v validator) ValidatePerson(in *publicpb.Person) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id),
@@ -1170,9 +2015,18 @@ func (v validator) ValidatePerson(in *publicpb.Person) error {
 			validation.Required,
 			validation.By(v.ByHobby),
 		),
+		validation.Field(&in.Nickname),
+		validation.Field(&in.Address,
+			validation.By(v.ByPerson_Address),
+		),
+		validation.Field(&in.Photo,
+			validation.By(v.ByExternalBytesValue),
+		),
+		validation.Field(&in.PreviousEmployment),
 	)
 }
 
+// ByPerson validates example.v2.Person as an ozzo-validation rule.
 func (v validator) ByPerson(value interface{}) error {
 	var in *publicpb.Person
 	if v, ok := value.(*publicpb.Person); ok {
@@ -1182,8 +2036,42 @@ func (v validator) ByPerson(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidatePerson(in)
 }
+
+// ValidatePerson_Address validates example.v2.Person.Address.
+func (v validator) ValidatePerson_Address(in *publicpb.Person_Address) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.City),
+	)
+}
+
+// ByPerson_Address validates example.v2.Person.Address as an ozzo-validation rule.
+func (v validator) ByPerson_Address(value interface{}) error {
+	var in *publicpb.Person_Address
+	if v, ok := value.(*publicpb.Person_Address); ok {
+		in = v
+	} else {
+		v := value.(publicpb.Person_Address)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidatePerson_Address(in)
+}
+
+// ValidateHobby validates example.v2.Hobby.
 func (v validator) ValidateHobby(in *publicpb.Hobby) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Type,
@@ -1192,6 +2080,7 @@ func (v validator) ValidateHobby(in *publicpb.Hobby) error {
 	)
 }
 
+// ByHobby validates example.v2.Hobby as an ozzo-validation rule.
 func (v validator) ByHobby(value interface{}) error {
 	var in *publicpb.Hobby
 	if v, ok := value.(*publicpb.Hobby); ok {
@@ -1201,14 +2090,23 @@ func (v validator) ByHobby(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateHobby(in)
 }
+
+// ValidateCoding validates example.v2.Coding.
 func (v validator) ValidateCoding(in *publicpb.Coding) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Language),
 	)
 }
 
+// ByCoding validates example.v2.Coding as an ozzo-validation rule.
 func (v validator) ByCoding(value interface{}) error {
 	var in *publicpb.Coding
 	if v, ok := value.(*publicpb.Coding); ok {
@@ -1218,14 +2116,23 @@ func (v validator) ByCoding(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateCoding(in)
 }
+
+// ValidateReading validates example.v2.Reading.
 func (v validator) ValidateReading(in *publicpb.Reading) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Genre),
 	)
 }
 
+// ByReading validates example.v2.Reading as an ozzo-validation rule.
 func (v validator) ByReading(value interface{}) error {
 	var in *publicpb.Reading
 	if v, ok := value.(*publicpb.Reading); ok {
@@ -1235,14 +2142,23 @@ func (v validator) ByReading(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateReading(in)
 }
+
+// ValidateCycling validates example.v2.Cycling.
 func (v validator) ValidateCycling(in *publicpb.Cycling) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Style),
 	)
 }
 
+// ByCycling validates example.v2.Cycling as an ozzo-validation rule.
 func (v validator) ByCycling(value interface{}) error {
 	var in *publicpb.Cycling
 	if v, ok := value.(*publicpb.Cycling); ok {
@@ -1252,8 +2168,16 @@ func (v validator) ByCycling(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateCycling(in)
 }
+
+// ValidateCreateRequest validates example.v2.CreateRequest.
 func (v validator) ValidateCreateRequest(in *publicpb.CreateRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id,
@@ -1270,9 +2194,11 @@ func (v validator) ValidateCreateRequest(in *publicpb.CreateRequest) error {
 			validation.Required,
 			validation.By(v.ByHobby),
 		),
+		validation.Field(&in.Nickname),
 	)
 }
 
+// ByCreateRequest validates example.v2.CreateRequest as an ozzo-validation rule.
 func (v validator) ByCreateRequest(value interface{}) error {
 	var in *publicpb.CreateRequest
 	if v, ok := value.(*publicpb.CreateRequest); ok {
@@ -1282,8 +2208,16 @@ func (v validator) ByCreateRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateCreateRequest(in)
 }
+
+// ValidateCreateResponse validates example.v2.CreateResponse.
 func (v validator) ValidateCreateResponse(in *publicpb.CreateResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Person,
@@ -1292,6 +2226,7 @@ func (v validator) ValidateCreateResponse(in *publicpb.CreateResponse) error {
 	)
 }
 
+// ByCreateResponse validates example.v2.CreateResponse as an ozzo-validation rule.
 func (v validator) ByCreateResponse(value interface{}) error {
 	var in *publicpb.CreateResponse
 	if v, ok := value.(*publicpb.CreateResponse); ok {
@@ -1301,8 +2236,16 @@ func (v validator) ByCreateResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateCreateResponse(in)
 }
+
+// ValidateGetRequest validates example.v2.GetRequest.
 func (v validator) ValidateGetRequest(in *publicpb.GetRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id,
@@ -1312,6 +2255,7 @@ func (v validator) ValidateGetRequest(in *publicpb.GetRequest) error {
 	)
 }
 
+// ByGetRequest validates example.v2.GetRequest as an ozzo-validation rule.
 func (v validator) ByGetRequest(value interface{}) error {
 	var in *publicpb.GetRequest
 	if v, ok := value.(*publicpb.GetRequest); ok {
@@ -1321,8 +2265,16 @@ func (v validator) ByGetRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateGetRequest(in)
 }
+
+// ValidateGetResponse validates example.v2.GetResponse.
 func (v validator) ValidateGetResponse(in *publicpb.GetResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Person,
@@ -1331,6 +2283,7 @@ func (v validator) ValidateGetResponse(in *publicpb.GetResponse) error {
 	)
 }
 
+// ByGetResponse validates example.v2.GetResponse as an ozzo-validation rule.
 func (v validator) ByGetResponse(value interface{}) error {
 	var in *publicpb.GetResponse
 	if v, ok := value.(*publicpb.GetResponse); ok {
@@ -1340,14 +2293,23 @@ func (v validator) ByGetResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateGetResponse(in)
 }
+
+// ValidateDeleteRequest validates example.v2.DeleteRequest.
 func (v validator) ValidateDeleteRequest(in *publicpb.DeleteRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id),
 	)
 }
 
+// ByDeleteRequest validates example.v2.DeleteRequest as an ozzo-validation rule.
 func (v validator) ByDeleteRequest(value interface{}) error {
 	var in *publicpb.DeleteRequest
 	if v, ok := value.(*publicpb.DeleteRequest); ok {
@@ -1357,12 +2319,21 @@ func (v validator) ByDeleteRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateDeleteRequest(in)
 }
+
+// ValidateDeleteResponse validates example.v2.DeleteResponse.
 func (v validator) ValidateDeleteResponse(in *publicpb.DeleteResponse) error {
 	return validation.ValidateStruct(in)
 }
 
+// ByDeleteResponse validates example.v2.DeleteResponse as an ozzo-validation rule.
 func (v validator) ByDeleteResponse(value interface{}) error {
 	var in *publicpb.DeleteResponse
 	if v, ok := value.(*publicpb.DeleteResponse); ok {
@@ -1372,8 +2343,16 @@ func (v validator) ByDeleteResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateDeleteResponse(in)
 }
+
+// ValidateUpdateRequest validates example.v2.UpdateRequest.
 func (v validator) ValidateUpdateRequest(in *publicpb.UpdateRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Id,
@@ -1387,6 +2366,7 @@ func (v validator) ValidateUpdateRequest(in *publicpb.UpdateRequest) error {
 	)
 }
 
+// ByUpdateRequest validates example.v2.UpdateRequest as an ozzo-validation rule.
 func (v validator) ByUpdateRequest(value interface{}) error {
 	var in *publicpb.UpdateRequest
 	if v, ok := value.(*publicpb.UpdateRequest); ok {
@@ -1396,8 +2376,16 @@ func (v validator) ByUpdateRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateUpdateRequest(in)
 }
+
+// ValidateUpdateResponse validates example.v2.UpdateResponse.
 func (v validator) ValidateUpdateResponse(in *publicpb.UpdateResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Person,
@@ -1406,6 +2394,7 @@ func (v validator) ValidateUpdateResponse(in *publicpb.UpdateResponse) error {
 	)
 }
 
+// ByUpdateResponse validates example.v2.UpdateResponse as an ozzo-validation rule.
 func (v validator) ByUpdateResponse(value interface{}) error {
 	var in *publicpb.UpdateResponse
 	if v, ok := value.(*publicpb.UpdateResponse); ok {
@@ -1415,8 +2404,16 @@ func (v validator) ByUpdateResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateUpdateResponse(in)
 }
+
+// ValidateBatchRequest validates example.v2.BatchRequest.
 func (v validator) ValidateBatchRequest(in *publicpb.BatchRequest) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.Creates,
@@ -1425,6 +2422,7 @@ func (v validator) ValidateBatchRequest(in *publicpb.BatchRequest) error {
 	)
 }
 
+// ByBatchRequest validates example.v2.BatchRequest as an ozzo-validation rule.
 func (v validator) ByBatchRequest(value interface{}) error {
 	var in *publicpb.BatchRequest
 	if v, ok := value.(*publicpb.BatchRequest); ok {
@@ -1434,8 +2432,16 @@ func (v validator) ByBatchRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateBatchRequest(in)
 }
+
+// ValidateBatchResponse validates example.v2.BatchResponse.
 func (v validator) ValidateBatchResponse(in *publicpb.BatchResponse) error {
 	return validation.ValidateStruct(in,
 		validation.Field(&in.People,
@@ -1444,6 +2450,7 @@ func (v validator) ValidateBatchResponse(in *publicpb.BatchResponse) error {
 	)
 }
 
+// ByBatchResponse validates example.v2.BatchResponse as an ozzo-validation rule.
 func (v validator) ByBatchResponse(value interface{}) error {
 	var in *publicpb.BatchResponse
 	if v, ok := value.(*publicpb.BatchResponse); ok {
@@ -1453,12 +2460,21 @@ func (v validator) ByBatchResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateBatchResponse(in)
 }
+
+// ValidatePingRequest validates example.v2.PingRequest.
 func (v validator) ValidatePingRequest(in *publicpb.PingRequest) error {
 	return validation.ValidateStruct(in)
 }
 
+// ByPingRequest validates example.v2.PingRequest as an ozzo-validation rule.
 func (v validator) ByPingRequest(value interface{}) error {
 	var in *publicpb.PingRequest
 	if v, ok := value.(*publicpb.PingRequest); ok {
@@ -1468,12 +2484,21 @@ func (v validator) ByPingRequest(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidatePingRequest(in)
 }
+
+// ValidatePingResponse validates example.v2.PingResponse.
 func (v validator) ValidatePingResponse(in *publicpb.PingResponse) error {
 	return validation.ValidateStruct(in)
 }
 
+// ByPingResponse validates example.v2.PingResponse as an ozzo-validation rule.
 func (v validator) ByPingResponse(value interface{}) error {
 	var in *publicpb.PingResponse
 	if v, ok := value.(*publicpb.PingResponse); ok {
@@ -1483,12 +2508,136 @@ func (v validator) ByPingResponse(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidatePingResponse(in)
 }
+
+// ValidateGetManyRequest validates example.v2.GetManyRequest.
+func (v validator) ValidateGetManyRequest(in *publicpb.GetManyRequest) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.Requests,
+			validation.Each(validation.By(v.ByGetRequest)),
+		),
+	)
+}
+
+// ByGetManyRequest validates example.v2.GetManyRequest as an ozzo-validation rule.
+func (v validator) ByGetManyRequest(value interface{}) error {
+	var in *publicpb.GetManyRequest
+	if v, ok := value.(*publicpb.GetManyRequest); ok {
+		in = v
+	} else {
+		v := value.(publicpb.GetManyRequest)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateGetManyRequest(in)
+}
+
+// ValidateGetManyResponse validates example.v2.GetManyResponse.
+func (v validator) ValidateGetManyResponse(in *publicpb.GetManyResponse) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.Responses,
+			validation.Each(validation.By(v.ByGetResponse)),
+		),
+	)
+}
+
+// ByGetManyResponse validates example.v2.GetManyResponse as an ozzo-validation rule.
+func (v validator) ByGetManyResponse(value interface{}) error {
+	var in *publicpb.GetManyResponse
+	if v, ok := value.(*publicpb.GetManyResponse); ok {
+		in = v
+	} else {
+		v := value.(publicpb.GetManyResponse)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateGetManyResponse(in)
+}
+
+// ValidateSearchRequest validates example.v2.SearchRequest.
+func (v validator) ValidateSearchRequest(in *publicpb.SearchRequest) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.PageSize,
+			validation.Max(100),
+		),
+		validation.Field(&in.PageToken),
+		validation.Field(&in.Query),
+	)
+}
+
+// BySearchRequest validates example.v2.SearchRequest as an ozzo-validation rule.
+func (v validator) BySearchRequest(value interface{}) error {
+	var in *publicpb.SearchRequest
+	if v, ok := value.(*publicpb.SearchRequest); ok {
+		in = v
+	} else {
+		v := value.(publicpb.SearchRequest)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateSearchRequest(in)
+}
+
+// ValidateSearchResponse validates example.v2.SearchResponse.
+func (v validator) ValidateSearchResponse(in *publicpb.SearchResponse) error {
+	return validation.ValidateStruct(in,
+		validation.Field(&in.People,
+			validation.Each(validation.By(v.ByPerson)),
+		),
+		validation.Field(&in.NextPageToken),
+	)
+}
+
+// BySearchResponse validates example.v2.SearchResponse as an ozzo-validation rule.
+func (v validator) BySearchResponse(value interface{}) error {
+	var in *publicpb.SearchResponse
+	if v, ok := value.(*publicpb.SearchResponse); ok {
+		in = v
+	} else {
+		v := value.(publicpb.SearchResponse)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateSearchResponse(in)
+}
+
+// ValidateExternalTimestamp validates google.protobuf.Timestamp.
 func (v validator) ValidateExternalTimestamp(in *exttimestamppb.Timestamp) error {
 	return nil
 }
 
+// ByExternalTimestamp validates google.protobuf.Timestamp as an ozzo-validation rule.
 func (v validator) ByExternalTimestamp(value interface{}) error {
 	var in *exttimestamppb.Timestamp
 	if v, ok := value.(*exttimestamppb.Timestamp); ok {
@@ -1498,9 +2647,44 @@ func (v validator) ByExternalTimestamp(value interface{}) error {
 		in = &v
 	}
 
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
 	return v.ValidateExternalTimestamp(in)
 }
 
+// ValidateExternalBytesValue validates google.protobuf.BytesValue.
+func (v validator) ValidateExternalBytesValue(in *extwrapperspb.BytesValue) error {
+	return nil
+}
+
+// ByExternalBytesValue validates google.protobuf.BytesValue as an ozzo-validation rule.
+func (v validator) ByExternalBytesValue(value interface{}) error {
+	var in *extwrapperspb.BytesValue
+	if v, ok := value.(*extwrapperspb.BytesValue); ok {
+		in = v
+	} else {
+		v := value.(extwrapperspb.BytesValue)
+		in = &v
+	}
+
+	// Unset messages are checked by the required rule of the field. The
+	// validator of the message dereferences its input, so it is not called.
+	if in == nil {
+		return nil
+	}
+
+	return v.ValidateExternalBytesValue(in)
+}
+
+// Create implements example.v2.People.Create.
+//
+// Create adds a person to the directory.
+//
+// example.v2.People.Create is delegated to example.private.People.Create.
 func (s *Service) Create(ctx context.Context, in *publicpb.CreateRequest) (*publicpb.CreateResponse, error) {
 	if err := s.ValidateCreateRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -1509,6 +2693,10 @@ func (s *Service) Create(ctx context.Context, in *publicpb.CreateRequest) (*publ
 	out, _, err := s.CreateImpl(ctx, in)
 	return out, err
 }
+
+// Get implements example.v2.People.Get.
+//
+// example.v2.People.Get is delegated to example.private.People.Fetch.
 func (s *Service) Get(ctx context.Context, in *publicpb.GetRequest) (*publicpb.GetResponse, error) {
 	if err := s.ValidateGetRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -1517,6 +2705,10 @@ func (s *Service) Get(ctx context.Context, in *publicpb.GetRequest) (*publicpb.G
 	out, _, err := s.GetImpl(ctx, in)
 	return out, err
 }
+
+// Delete implements example.v2.People.Delete.
+//
+// example.v2.People.Delete is delegated to example.private.People.Delete.
 func (s *Service) Delete(ctx context.Context, in *publicpb.DeleteRequest) (*publicpb.DeleteResponse, error) {
 	if err := s.ValidateDeleteRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -1525,6 +2717,10 @@ func (s *Service) Delete(ctx context.Context, in *publicpb.DeleteRequest) (*publ
 	out, _, err := s.DeleteImpl(ctx, in)
 	return out, err
 }
+
+// Update implements example.v2.People.Update.
+//
+// example.v2.People.Update is delegated to example.private.People.Update.
 func (s *Service) Update(ctx context.Context, in *publicpb.UpdateRequest) (*publicpb.UpdateResponse, error) {
 	if err := s.ValidateUpdateRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -1533,6 +2729,10 @@ func (s *Service) Update(ctx context.Context, in *publicpb.UpdateRequest) (*publ
 	out, _, err := s.UpdateImpl(ctx, in)
 	return out, err
 }
+
+// Batch implements example.v2.People.Batch.
+//
+// example.v2.People.Batch is delegated to example.private.People.Batch.
 func (s *Service) Batch(ctx context.Context, in *publicpb.BatchRequest) (*publicpb.BatchResponse, error) {
 	if err := s.ValidateBatchRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -1541,6 +2741,10 @@ func (s *Service) Batch(ctx context.Context, in *publicpb.BatchRequest) (*public
 	out, _, err := s.BatchImpl(ctx, in)
 	return out, err
 }
+
+// Ping implements example.v2.People.Ping.
+//
+// example.v2.People.Ping is delegated to example.private.People.Ping.
 func (s *Service) Ping(ctx context.Context, in *publicpb.PingRequest) (*publicpb.PingResponse, error) {
 	if err := s.ValidatePingRequest(in); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
@@ -1550,16 +2754,47 @@ func (s *Service) Ping(ctx context.Context, in *publicpb.PingRequest) (*publicpb
 	return out, err
 }
 
+// Search implements example.v2.People.Search.
+//
+// Search pages through the people whose full name contains the query.
+//
+// example.v2.People.Search is delegated to example.private.People.Search.
+func (s *Service) Search(ctx context.Context, in *publicpb.SearchRequest) (*publicpb.SearchResponse, error) {
+	if err := s.ValidateSearchRequest(in); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	out, _, err := s.SearchImpl(ctx, in)
+	return out, err
+}
+
+// GetMany implements example.v2.People.GetMany.
+//
+// example.v2.People.GetMany calls example.v2.People.Get for each item of example.v2.GetManyRequest.requests.
+func (s *Service) GetMany(ctx context.Context, in *publicpb.GetManyRequest) (*publicpb.GetManyResponse, error) {
+	if err := s.ValidateGetManyRequest(in); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	return s.GetManyImpl(ctx, in)
+}
+
+// CreateImpl implements example.v2.People.Create and returns the private output.
+//
+// example.v2.People.Create is delegated to example.private.People.Create.
 func (s *Service) CreateImpl(ctx context.Context, in *publicpb.CreateRequest, mutators ...private.CreateRequestMutator) (*publicpb.CreateResponse, *privatepb.CreateResponse, error) {
 	// Set mutators for all deprecated fields
-	inPriv := s.ToPrivateCreateRequest(in)
+	inPriv, err := s.ToPrivateCreateRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
 	for _, mutator := range mutators {
 		mutator(inPriv)
 	}
 
 	outPriv, err := s.Private.Create(ctx, inPriv)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, toPublicError(err, s.ToPublicCreateRequestFieldPath)
 	}
 
 	out, err := s.ToPublicCreateResponse(outPriv)
@@ -1568,16 +2803,23 @@ func (s *Service) CreateImpl(ctx context.Context, in *publicpb.CreateRequest, mu
 	}
 	return out, outPriv, nil
 }
+
+// GetImpl implements example.v2.People.Get and returns the private output.
+//
+// example.v2.People.Get is delegated to example.private.People.Fetch.
 func (s *Service) GetImpl(ctx context.Context, in *publicpb.GetRequest, mutators ...private.FetchRequestMutator) (*publicpb.GetResponse, *privatepb.FetchResponse, error) {
 	// Set mutators for all deprecated fields
-	inPriv := s.ToPrivateFetchRequest(in)
+	inPriv, err := s.ToPrivateFetchRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
 	for _, mutator := range mutators {
 		mutator(inPriv)
 	}
 
 	outPriv, err := s.Private.Fetch(ctx, inPriv)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, toPublicError(err, s.ToPublicGetRequestFieldPath)
 	}
 
 	out, err := s.ToPublicGetResponse(outPriv)
@@ -1586,16 +2828,23 @@ func (s *Service) GetImpl(ctx context.Context, in *publicpb.GetRequest, mutators
 	}
 	return out, outPriv, nil
 }
+
+// DeleteImpl implements example.v2.People.Delete and returns the private output.
+//
+// example.v2.People.Delete is delegated to example.private.People.Delete.
 func (s *Service) DeleteImpl(ctx context.Context, in *publicpb.DeleteRequest, mutators ...private.DeleteRequestMutator) (*publicpb.DeleteResponse, *privatepb.DeleteResponse, error) {
 	// Set mutators for all deprecated fields
-	inPriv := s.ToPrivateDeleteRequest(in)
+	inPriv, err := s.ToPrivateDeleteRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
 	for _, mutator := range mutators {
 		mutator(inPriv)
 	}
 
 	outPriv, err := s.Private.Delete(ctx, inPriv)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, toPublicError(err, s.ToPublicDeleteRequestFieldPath)
 	}
 
 	out, err := s.ToPublicDeleteResponse(outPriv)
@@ -1604,16 +2853,23 @@ func (s *Service) DeleteImpl(ctx context.Context, in *publicpb.DeleteRequest, mu
 	}
 	return out, outPriv, nil
 }
+
+// UpdateImpl implements example.v2.People.Update and returns the private output.
+//
+// example.v2.People.Update is delegated to example.private.People.Update.
 func (s *Service) UpdateImpl(ctx context.Context, in *publicpb.UpdateRequest, mutators ...private.UpdateRequestMutator) (*publicpb.UpdateResponse, *privatepb.UpdateResponse, error) {
 	// Set mutators for all deprecated fields
-	inPriv := s.ToPrivateUpdateRequest(in)
+	inPriv, err := s.ToPrivateUpdateRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
 	for _, mutator := range mutators {
 		mutator(inPriv)
 	}
 
 	outPriv, err := s.Private.Update(ctx, inPriv)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, toPublicError(err, s.ToPublicUpdateRequestFieldPath)
 	}
 
 	out, err := s.ToPublicUpdateResponse(outPriv)
@@ -1622,16 +2878,23 @@ func (s *Service) UpdateImpl(ctx context.Context, in *publicpb.UpdateRequest, mu
 	}
 	return out, outPriv, nil
 }
+
+// BatchImpl implements example.v2.People.Batch and returns the private output.
+//
+// example.v2.People.Batch is delegated to example.private.People.Batch.
 func (s *Service) BatchImpl(ctx context.Context, in *publicpb.BatchRequest, mutators ...private.BatchRequestMutator) (*publicpb.BatchResponse, *privatepb.BatchResponse, error) {
 	// Set mutators for all deprecated fields
-	inPriv := s.ToPrivateBatchRequest(in)
+	inPriv, err := s.ToPrivateBatchRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
 	for _, mutator := range mutators {
 		mutator(inPriv)
 	}
 
 	outPriv, err := s.Private.Batch(ctx, inPriv)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, toPublicError(err, s.ToPublicBatchRequestFieldPath)
 	}
 
 	out, err := s.ToPublicBatchResponse(outPriv)
@@ -1640,16 +2903,23 @@ func (s *Service) BatchImpl(ctx context.Context, in *publicpb.BatchRequest, muta
 	}
 	return out, outPriv, nil
 }
+
+// PingImpl implements example.v2.People.Ping and returns the private output.
+//
+// example.v2.People.Ping is delegated to example.private.People.Ping.
 func (s *Service) PingImpl(ctx context.Context, in *publicpb.PingRequest, mutators ...private.PingRequestMutator) (*publicpb.PingResponse, *privatepb.PingResponse, error) {
 	// Set mutators for all deprecated fields
-	inPriv := s.ToPrivatePingRequest(in)
+	inPriv, err := s.ToPrivatePingRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
 	for _, mutator := range mutators {
 		mutator(inPriv)
 	}
 
 	outPriv, err := s.Private.Ping(ctx, inPriv)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, toPublicError(err, s.ToPublicPingRequestFieldPath)
 	}
 
 	out, err := s.ToPublicPingResponse(outPriv)
@@ -1658,3 +2928,179 @@ func (s *Service) PingImpl(ctx context.Context, in *publicpb.PingRequest, mutato
 	}
 	return out, outPriv, nil
 }
+
+// SearchImpl implements example.v2.People.Search and returns the private output.
+//
+// example.v2.People.Search is delegated to example.private.People.Search.
+func (s *Service) SearchImpl(ctx context.Context, in *publicpb.SearchRequest, mutators ...private.SearchRequestMutator) (*publicpb.SearchResponse, *privatepb.SearchResponse, error) {
+	// Set mutators for all deprecated fields
+	inPriv, err := s.ToPrivateSearchRequest(in)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	for _, mutator := range mutators {
+		mutator(inPriv)
+	}
+
+	offset, err := s.pageTokenCodec().Decode(in.PageToken)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+
+	inPriv.Offset = int32(offset)
+	inPriv.Limit = int32(in.PageSize)
+
+	outPriv, err := s.Private.Search(ctx, inPriv)
+	if err != nil {
+		return nil, nil, toPublicError(err, s.ToPublicSearchRequestFieldPath)
+	}
+
+	out, err := s.ToPublicSearchResponse(outPriv)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+	}
+
+	// A full page of results indicates there may be another page. A limit
+	// of zero requests the default page size of the called method, so any
+	// results may be followed by another page.
+	if n, limit := int64(len(out.GetPeople())), int64(inPriv.Limit); n > 0 && (limit == 0 || n >= limit) {
+		out.NextPageToken = s.pageTokenCodec().Encode(offset + n)
+	}
+
+	return out, outPriv, nil
+}
+
+// GetManyImpl calls Get for each item of `requests`.
+// Outputs are collected in the order of the inputs. The first error to
+// occur cancels the remaining calls, and is returned with field paths
+// prefixed by the index of its input.
+func (s *Service) GetManyImpl(ctx context.Context, in *publicpb.GetManyRequest) (*publicpb.GetManyResponse, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var out publicpb.GetManyResponse
+	out.Responses = make([]*publicpb.GetResponse, len(in.Requests))
+
+	sem := make(chan struct{}, 4)
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		index int
+		first error
+	)
+
+	for i, item := range in.Requests {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, item *publicpb.GetRequest) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			var err error
+			out.Responses[i], _, err = s.GetImpl(ctx, item)
+			if err != nil {
+				once.Do(func() {
+					index, first = i, err
+					cancel()
+				})
+			}
+		}(i, item)
+	}
+	wg.Wait()
+
+	if first != nil {
+		path := fmt.Sprintf("[%d]", index)
+		return nil, toPublicError(first, func(p string) string {
+			return joinFieldPath("requests", path, p)
+		})
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// fieldPathKeys are the keys of `ErrorInfo` metadata holding a field path.
+var fieldPathKeys = []string{"field"}
+
+// toPublicError rewrites the field paths found in the details of a status
+// error with `fieldPath`. Field violations of `BadRequest` details and the
+// metadata values of `ErrorInfo` details under `fieldPathKeys` are rewritten.
+// All other errors, details and metadata values are returned as is.
+func toPublicError(err error, fieldPath func(string) string) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	pb := st.Proto()
+	if len(pb.Details) == 0 {
+		return err
+	}
+
+	for i, detail := range pb.Details {
+		msg, err := detail.UnmarshalNew()
+		if err != nil {
+			continue
+		}
+
+		switch d := msg.(type) {
+		case *errdetails.BadRequest:
+			for _, violation := range d.FieldViolations {
+				violation.Field = fieldPath(violation.Field)
+			}
+		case *errdetails.ErrorInfo:
+			for _, key := range fieldPathKeys {
+				if value, ok := d.Metadata[key]; ok {
+					d.Metadata[key] = fieldPath(value)
+				}
+			}
+		default:
+			continue
+		}
+
+		value, err := anypb.New(msg)
+		if err != nil {
+			continue
+		}
+
+		pb.Details[i] = value
+	}
+
+	return status.ErrorProto(pb)
+}
+
+// splitFieldPath splits a field path such as `items[0].name` into the name of
+// the first field, its index and the remainder of the path.
+func splitFieldPath(path string) (string, string, string) {
+	name, rest := path, ""
+	if i := strings.IndexByte(name, '.'); i >= 0 {
+		name, rest = name[:i], name[i+1:]
+	}
+
+	var index string
+	if i := strings.IndexByte(name, '['); i >= 0 {
+		name, index = name[:i], name[i:]
+	}
+
+	return name, index, rest
+}
+
+// joinFieldPath reverses splitFieldPath.
+func joinFieldPath(name, index, rest string) string {
+	if rest == "" {
+		return name + index
+	}
+
+	return name + index + "." + rest
+}
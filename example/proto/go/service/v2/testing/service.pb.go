@@ -1,32 +1,79 @@
 package testing
 
 import (
+	"bytes"
 	"context"
-	"io/ioutil"
+	"encoding/json"
+	"fmt"
+	"math/rand"
 	"net"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
 	exttimestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	extwrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 
 	privatepb "github.com/dane/protoc-gen-go-svc/example/proto/go/private"
 	service "github.com/dane/protoc-gen-go-svc/example/proto/go/service"
+	v2svc "github.com/dane/protoc-gen-go-svc/example/proto/go/service/v2"
 	publicpb "github.com/dane/protoc-gen-go-svc/example/proto/go/v2"
 )
 
 type TestFunc func(*testing.T, Params, []service.Option)
 
+type FuzzFunc func(*testing.F, []service.Option)
+
+// UpdateEnv is the environment variable enabling the record mode of every
+// conversion test when it is set to a non-empty value.
+const UpdateEnv = "SVC_UPDATE_FIXTURES"
+
 type Params struct {
 	PublicInput   string
 	PublicOutput  string
 	PrivateInput  string
 	PrivateOutput string
+
+	// Update enables the record mode of the conversion test. The private input
+	// received by the private server and the public output returned by the
+	// method are written to the `PrivateInput` and `PublicOutput` files when
+	// they are missing or differ, rather than failing the test.
+	Update bool
+
+	// Transport is the transport between the public client and the service
+	// versions. Services are served over `bufconn` by default.
+	Transport Transport
 }
 
+// Transport is an in-process transport between the public client of a test and
+// the service versions.
+type Transport int
+
+const (
+	// TransportBufconn serves the service versions with a gRPC server over an
+	// in-memory `bufconn` listener.
+	TransportBufconn Transport = iota
+
+	// TransportDirect invokes the method handlers of the service versions
+	// directly, without a gRPC server or listener.
+	TransportDirect
+)
+
 func NewCreateConversionTest(t *testing.T, params Params, options []service.Option) {
 	t.Run(`verify conversions between "v2" and "private"`, func(t *testing.T) {
 		var (
@@ -43,8 +90,16 @@ func NewCreateConversionTest(t *testing.T, params Params, options []service.Opti
 			params.PrivateOutput: &privateOut,
 		}
 
+		update := params.Update || os.Getenv(UpdateEnv) != ""
+		missing := make(map[string]bool)
+
 		for fileName, dst := range files {
-			b, err := ioutil.ReadFile(fileName)
+			b, err := os.ReadFile(fileName)
+			if os.IsNotExist(err) && update && (fileName == params.PublicOutput || fileName == params.PrivateInput) {
+				missing[fileName] = true
+				continue
+			}
+
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -59,20 +114,21 @@ func NewCreateConversionTest(t *testing.T, params Params, options []service.Opti
 			CreateInput:  &privateIn,
 			CreateOutput: &privateOut,
 		}
-		addr, cleanup := startServer(t, s, options)
+		conn, cleanup := startServer(t, s, options, params.Transport)
 		defer cleanup()
 
-		conn, err := grpc.Dial(addr, grpc.WithInsecure())
-		if err != nil {
-			t.Fatal(err)
-		}
-
 		client := publicpb.NewPeopleClient(conn)
 		out, err := client.Create(ctx, &publicIn)
 		if err != nil {
 			t.Fatal(err)
 		}
 
+		if update {
+			record(t, params.PublicOutput, missing[params.PublicOutput], &publicOut, out)
+			record(t, params.PrivateInput, missing[params.PrivateInput], &privateIn, s.CreateReceived)
+			return
+		}
+
 		if !cmp.Equal(out, &publicOut, ignore()...) {
 			t.Fatal(cmp.Diff(out, &publicOut, ignore()...))
 		}
@@ -98,8 +154,16 @@ func NewGetConversionTest(t *testing.T, params Params, options []service.Option)
 			params.PrivateOutput: &privateOut,
 		}
 
+		update := params.Update || os.Getenv(UpdateEnv) != ""
+		missing := make(map[string]bool)
+
 		for fileName, dst := range files {
-			b, err := ioutil.ReadFile(fileName)
+			b, err := os.ReadFile(fileName)
+			if os.IsNotExist(err) && update && (fileName == params.PublicOutput || fileName == params.PrivateInput) {
+				missing[fileName] = true
+				continue
+			}
+
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -114,20 +178,21 @@ func NewGetConversionTest(t *testing.T, params Params, options []service.Option)
 			FetchInput:  &privateIn,
 			FetchOutput: &privateOut,
 		}
-		addr, cleanup := startServer(t, s, options)
+		conn, cleanup := startServer(t, s, options, params.Transport)
 		defer cleanup()
 
-		conn, err := grpc.Dial(addr, grpc.WithInsecure())
-		if err != nil {
-			t.Fatal(err)
-		}
-
 		client := publicpb.NewPeopleClient(conn)
 		out, err := client.Get(ctx, &publicIn)
 		if err != nil {
 			t.Fatal(err)
 		}
 
+		if update {
+			record(t, params.PublicOutput, missing[params.PublicOutput], &publicOut, out)
+			record(t, params.PrivateInput, missing[params.PrivateInput], &privateIn, s.FetchReceived)
+			return
+		}
+
 		if !cmp.Equal(out, &publicOut, ignore()...) {
 			t.Fatal(cmp.Diff(out, &publicOut, ignore()...))
 		}
@@ -153,8 +218,16 @@ func NewDeleteConversionTest(t *testing.T, params Params, options []service.Opti
 			params.PrivateOutput: &privateOut,
 		}
 
+		update := params.Update || os.Getenv(UpdateEnv) != ""
+		missing := make(map[string]bool)
+
 		for fileName, dst := range files {
-			b, err := ioutil.ReadFile(fileName)
+			b, err := os.ReadFile(fileName)
+			if os.IsNotExist(err) && update && (fileName == params.PublicOutput || fileName == params.PrivateInput) {
+				missing[fileName] = true
+				continue
+			}
+
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -169,20 +242,21 @@ func NewDeleteConversionTest(t *testing.T, params Params, options []service.Opti
 			DeleteInput:  &privateIn,
 			DeleteOutput: &privateOut,
 		}
-		addr, cleanup := startServer(t, s, options)
+		conn, cleanup := startServer(t, s, options, params.Transport)
 		defer cleanup()
 
-		conn, err := grpc.Dial(addr, grpc.WithInsecure())
-		if err != nil {
-			t.Fatal(err)
-		}
-
 		client := publicpb.NewPeopleClient(conn)
 		out, err := client.Delete(ctx, &publicIn)
 		if err != nil {
 			t.Fatal(err)
 		}
 
+		if update {
+			record(t, params.PublicOutput, missing[params.PublicOutput], &publicOut, out)
+			record(t, params.PrivateInput, missing[params.PrivateInput], &privateIn, s.DeleteReceived)
+			return
+		}
+
 		if !cmp.Equal(out, &publicOut, ignore()...) {
 			t.Fatal(cmp.Diff(out, &publicOut, ignore()...))
 		}
@@ -208,8 +282,16 @@ func NewUpdateConversionTest(t *testing.T, params Params, options []service.Opti
 			params.PrivateOutput: &privateOut,
 		}
 
+		update := params.Update || os.Getenv(UpdateEnv) != ""
+		missing := make(map[string]bool)
+
 		for fileName, dst := range files {
-			b, err := ioutil.ReadFile(fileName)
+			b, err := os.ReadFile(fileName)
+			if os.IsNotExist(err) && update && (fileName == params.PublicOutput || fileName == params.PrivateInput) {
+				missing[fileName] = true
+				continue
+			}
+
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -224,20 +306,21 @@ func NewUpdateConversionTest(t *testing.T, params Params, options []service.Opti
 			UpdateInput:  &privateIn,
 			UpdateOutput: &privateOut,
 		}
-		addr, cleanup := startServer(t, s, options)
+		conn, cleanup := startServer(t, s, options, params.Transport)
 		defer cleanup()
 
-		conn, err := grpc.Dial(addr, grpc.WithInsecure())
-		if err != nil {
-			t.Fatal(err)
-		}
-
 		client := publicpb.NewPeopleClient(conn)
 		out, err := client.Update(ctx, &publicIn)
 		if err != nil {
 			t.Fatal(err)
 		}
 
+		if update {
+			record(t, params.PublicOutput, missing[params.PublicOutput], &publicOut, out)
+			record(t, params.PrivateInput, missing[params.PrivateInput], &privateIn, s.UpdateReceived)
+			return
+		}
+
 		if !cmp.Equal(out, &publicOut, ignore()...) {
 			t.Fatal(cmp.Diff(out, &publicOut, ignore()...))
 		}
@@ -263,8 +346,16 @@ func NewBatchConversionTest(t *testing.T, params Params, options []service.Optio
 			params.PrivateOutput: &privateOut,
 		}
 
+		update := params.Update || os.Getenv(UpdateEnv) != ""
+		missing := make(map[string]bool)
+
 		for fileName, dst := range files {
-			b, err := ioutil.ReadFile(fileName)
+			b, err := os.ReadFile(fileName)
+			if os.IsNotExist(err) && update && (fileName == params.PublicOutput || fileName == params.PrivateInput) {
+				missing[fileName] = true
+				continue
+			}
+
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -279,20 +370,21 @@ func NewBatchConversionTest(t *testing.T, params Params, options []service.Optio
 			BatchInput:  &privateIn,
 			BatchOutput: &privateOut,
 		}
-		addr, cleanup := startServer(t, s, options)
+		conn, cleanup := startServer(t, s, options, params.Transport)
 		defer cleanup()
 
-		conn, err := grpc.Dial(addr, grpc.WithInsecure())
-		if err != nil {
-			t.Fatal(err)
-		}
-
 		client := publicpb.NewPeopleClient(conn)
 		out, err := client.Batch(ctx, &publicIn)
 		if err != nil {
 			t.Fatal(err)
 		}
 
+		if update {
+			record(t, params.PublicOutput, missing[params.PublicOutput], &publicOut, out)
+			record(t, params.PrivateInput, missing[params.PrivateInput], &privateIn, s.BatchReceived)
+			return
+		}
+
 		if !cmp.Equal(out, &publicOut, ignore()...) {
 			t.Fatal(cmp.Diff(out, &publicOut, ignore()...))
 		}
@@ -318,8 +410,16 @@ func NewPingConversionTest(t *testing.T, params Params, options []service.Option
 			params.PrivateOutput: &privateOut,
 		}
 
+		update := params.Update || os.Getenv(UpdateEnv) != ""
+		missing := make(map[string]bool)
+
 		for fileName, dst := range files {
-			b, err := ioutil.ReadFile(fileName)
+			b, err := os.ReadFile(fileName)
+			if os.IsNotExist(err) && update && (fileName == params.PublicOutput || fileName == params.PrivateInput) {
+				missing[fileName] = true
+				continue
+			}
+
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -334,20 +434,85 @@ func NewPingConversionTest(t *testing.T, params Params, options []service.Option
 			PingInput:  &privateIn,
 			PingOutput: &privateOut,
 		}
-		addr, cleanup := startServer(t, s, options)
+		conn, cleanup := startServer(t, s, options, params.Transport)
 		defer cleanup()
 
-		conn, err := grpc.Dial(addr, grpc.WithInsecure())
+		client := publicpb.NewPeopleClient(conn)
+		out, err := client.Ping(ctx, &publicIn)
 		if err != nil {
 			t.Fatal(err)
 		}
 
+		if update {
+			record(t, params.PublicOutput, missing[params.PublicOutput], &publicOut, out)
+			record(t, params.PrivateInput, missing[params.PrivateInput], &privateIn, s.PingReceived)
+			return
+		}
+
+		if !cmp.Equal(out, &publicOut, ignore()...) {
+			t.Fatal(cmp.Diff(out, &publicOut, ignore()...))
+		}
+
+		if s.diff != "" {
+			t.Fatal(s.diff)
+		}
+	})
+}
+func NewSearchConversionTest(t *testing.T, params Params, options []service.Option) {
+	t.Run(`verify conversions between "v2" and "private"`, func(t *testing.T) {
+		var (
+			publicIn   publicpb.SearchRequest
+			publicOut  publicpb.SearchResponse
+			privateIn  privatepb.SearchRequest
+			privateOut privatepb.SearchResponse
+		)
+
+		files := map[string]protoreflect.ProtoMessage{
+			params.PublicInput:   &publicIn,
+			params.PublicOutput:  &publicOut,
+			params.PrivateInput:  &privateIn,
+			params.PrivateOutput: &privateOut,
+		}
+
+		update := params.Update || os.Getenv(UpdateEnv) != ""
+		missing := make(map[string]bool)
+
+		for fileName, dst := range files {
+			b, err := os.ReadFile(fileName)
+			if os.IsNotExist(err) && update && (fileName == params.PublicOutput || fileName == params.PrivateInput) {
+				missing[fileName] = true
+				continue
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := protojson.Unmarshal(b, dst); err != nil {
+				t.Fatalf("%s: %s", fileName, err)
+			}
+		}
+
+		ctx := context.Background()
+		s := &server{
+			SearchInput:  &privateIn,
+			SearchOutput: &privateOut,
+		}
+		conn, cleanup := startServer(t, s, options, params.Transport)
+		defer cleanup()
+
 		client := publicpb.NewPeopleClient(conn)
-		out, err := client.Ping(ctx, &publicIn)
+		out, err := client.Search(ctx, &publicIn)
 		if err != nil {
 			t.Fatal(err)
 		}
 
+		if update {
+			record(t, params.PublicOutput, missing[params.PublicOutput], &publicOut, out)
+			record(t, params.PrivateInput, missing[params.PrivateInput], &privateIn, s.SearchReceived)
+			return
+		}
+
 		if !cmp.Equal(out, &publicOut, ignore()...) {
 			t.Fatal(cmp.Diff(out, &publicOut, ignore()...))
 		}
@@ -357,42 +522,147 @@ func NewPingConversionTest(t *testing.T, params Params, options []service.Option
 		}
 	})
 }
-func startServer(t *testing.T, ts privatepb.PeopleServer, options []service.Option) (string, func()) {
-	ln, err := net.Listen("tcp", ":0")
+
+// record writes a message observed by a conversion test to a fixture file when
+// the file is missing or holds a different message. Fixtures are written with
+// proto field names and enum numbers, indented by four spaces.
+func record(t *testing.T, fileName string, missing bool, want, got proto.Message) {
+	if !missing && proto.Equal(want, got) {
+		return
+	}
+
+	b, err := protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true}.Marshal(got)
 	if err != nil {
+		t.Fatalf("%s: %s", fileName, err)
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, b, "", "    "); err != nil {
+		t.Fatalf("%s: %s", fileName, err)
+	}
+	buf.WriteByte('\n')
+
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
 		t.Fatal(err)
 	}
 
-	srv := grpc.NewServer()
+	if err := os.WriteFile(fileName, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("updated %s", fileName)
+}
+
+// bufSize is the buffer size of the in-memory listener of each test server.
+const bufSize = 1024 * 1024
+
+// startServer registers the service versions with the fake private server and
+// returns a client connection to them over the transport. Server options only
+// apply to the `bufconn` transport.
+func startServer(t testing.TB, ts privatepb.PeopleServer, options []service.Option, transport Transport, opts ...grpc.ServerOption) (grpc.ClientConnInterface, func()) {
+	if transport == TransportDirect {
+		conn := &directConn{methods: make(map[string]directMethod)}
+		service.RegisterServer(conn, ts, options...)
+		return conn, func() {}
+	}
+
+	ln := bufconn.Listen(bufSize)
+	srv := grpc.NewServer(opts...)
 	service.RegisterServer(srv, ts, options...)
 
-	go func(t *testing.T, srv *grpc.Server, ln net.Listener) {
-		if err := srv.Serve(ln); err != nil {
-			t.Fatal(err)
+	go func() {
+		_ = srv.Serve(ln)
+	}()
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return ln.DialContext(ctx)
+	}
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(dialer),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		srv.Stop()
+		t.Fatal(err)
+	}
+
+	return conn, func() {
+		conn.Close()
+		srv.Stop()
+	}
+}
+
+type directMethod func(ctx context.Context, dec func(interface{}) error) (interface{}, error)
+
+// directConn is a client connection invoking the unary method handlers of the
+// services registered with it, without a gRPC server. Errors are converted to
+// status errors as they would be by a gRPC server.
+type directConn struct {
+	methods map[string]directMethod
+}
+
+func (c *directConn) RegisterService(desc *grpc.ServiceDesc, impl interface{}) {
+	for _, m := range desc.Methods {
+		handler := m.Handler
+		c.methods["/"+desc.ServiceName+"/"+m.MethodName] = func(ctx context.Context, dec func(interface{}) error) (interface{}, error) {
+			return handler(impl, ctx, dec, nil)
 		}
-	}(t, srv, ln)
+	}
+}
+
+func (c *directConn) Invoke(ctx context.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+	fn, ok := c.methods[method]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+
+	dec := func(in interface{}) error {
+		proto.Merge(in.(proto.Message), args.(proto.Message))
+		return nil
+	}
+
+	out, err := fn(ctx, dec)
+	if err != nil {
+		return status.Convert(err).Err()
+	}
+
+	proto.Merge(reply.(proto.Message), out.(proto.Message))
+	return nil
+}
 
-	return ln.Addr().String(), srv.Stop
+func (c *directConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Error(codes.Unimplemented, "streams are not supported by the direct transport")
 }
 
 type server struct {
 	privatepb.PeopleServer
-	diff         string
-	CreateInput  *privatepb.CreateRequest
-	CreateOutput *privatepb.CreateResponse
-	FetchInput   *privatepb.FetchRequest
-	FetchOutput  *privatepb.FetchResponse
-	DeleteInput  *privatepb.DeleteRequest
-	DeleteOutput *privatepb.DeleteResponse
-	UpdateInput  *privatepb.UpdateRequest
-	UpdateOutput *privatepb.UpdateResponse
-	BatchInput   *privatepb.BatchRequest
-	BatchOutput  *privatepb.BatchResponse
-	PingInput    *privatepb.PingRequest
-	PingOutput   *privatepb.PingResponse
+	diff           string
+	CreateInput    *privatepb.CreateRequest
+	CreateOutput   *privatepb.CreateResponse
+	CreateReceived *privatepb.CreateRequest
+	FetchInput     *privatepb.FetchRequest
+	FetchOutput    *privatepb.FetchResponse
+	FetchReceived  *privatepb.FetchRequest
+	DeleteInput    *privatepb.DeleteRequest
+	DeleteOutput   *privatepb.DeleteResponse
+	DeleteReceived *privatepb.DeleteRequest
+	UpdateInput    *privatepb.UpdateRequest
+	UpdateOutput   *privatepb.UpdateResponse
+	UpdateReceived *privatepb.UpdateRequest
+	BatchInput     *privatepb.BatchRequest
+	BatchOutput    *privatepb.BatchResponse
+	BatchReceived  *privatepb.BatchRequest
+	PingInput      *privatepb.PingRequest
+	PingOutput     *privatepb.PingResponse
+	PingReceived   *privatepb.PingRequest
+	SearchInput    *privatepb.SearchRequest
+	SearchOutput   *privatepb.SearchResponse
+	SearchReceived *privatepb.SearchRequest
 }
 
 func (s *server) Create(_ context.Context, in *privatepb.CreateRequest) (*privatepb.CreateResponse, error) {
+	s.CreateReceived = in
 	if !cmp.Equal(in, s.CreateInput, ignore()...) {
 		s.diff = cmp.Diff(in, s.CreateInput, ignore()...)
 	}
@@ -400,6 +670,7 @@ func (s *server) Create(_ context.Context, in *privatepb.CreateRequest) (*privat
 	return s.CreateOutput, nil
 }
 func (s *server) Fetch(_ context.Context, in *privatepb.FetchRequest) (*privatepb.FetchResponse, error) {
+	s.FetchReceived = in
 	if !cmp.Equal(in, s.FetchInput, ignore()...) {
 		s.diff = cmp.Diff(in, s.FetchInput, ignore()...)
 	}
@@ -407,6 +678,7 @@ func (s *server) Fetch(_ context.Context, in *privatepb.FetchRequest) (*privatep
 	return s.FetchOutput, nil
 }
 func (s *server) Delete(_ context.Context, in *privatepb.DeleteRequest) (*privatepb.DeleteResponse, error) {
+	s.DeleteReceived = in
 	if !cmp.Equal(in, s.DeleteInput, ignore()...) {
 		s.diff = cmp.Diff(in, s.DeleteInput, ignore()...)
 	}
@@ -414,6 +686,7 @@ func (s *server) Delete(_ context.Context, in *privatepb.DeleteRequest) (*privat
 	return s.DeleteOutput, nil
 }
 func (s *server) Update(_ context.Context, in *privatepb.UpdateRequest) (*privatepb.UpdateResponse, error) {
+	s.UpdateReceived = in
 	if !cmp.Equal(in, s.UpdateInput, ignore()...) {
 		s.diff = cmp.Diff(in, s.UpdateInput, ignore()...)
 	}
@@ -421,6 +694,7 @@ func (s *server) Update(_ context.Context, in *privatepb.UpdateRequest) (*privat
 	return s.UpdateOutput, nil
 }
 func (s *server) Batch(_ context.Context, in *privatepb.BatchRequest) (*privatepb.BatchResponse, error) {
+	s.BatchReceived = in
 	if !cmp.Equal(in, s.BatchInput, ignore()...) {
 		s.diff = cmp.Diff(in, s.BatchInput, ignore()...)
 	}
@@ -428,16 +702,27 @@ func (s *server) Batch(_ context.Context, in *privatepb.BatchRequest) (*privatep
 	return s.BatchOutput, nil
 }
 func (s *server) Ping(_ context.Context, in *privatepb.PingRequest) (*privatepb.PingResponse, error) {
+	s.PingReceived = in
 	if !cmp.Equal(in, s.PingInput, ignore()...) {
 		s.diff = cmp.Diff(in, s.PingInput, ignore()...)
 	}
 
 	return s.PingOutput, nil
 }
+func (s *server) Search(_ context.Context, in *privatepb.SearchRequest) (*privatepb.SearchResponse, error) {
+	s.SearchReceived = in
+	if !cmp.Equal(in, s.SearchInput, ignore()...) {
+		s.diff = cmp.Diff(in, s.SearchInput, ignore()...)
+	}
+
+	return s.SearchOutput, nil
+}
 func ignore() []cmp.Option {
 	return []cmp.Option{
 		cmpopts.IgnoreUnexported(publicpb.Person{}),
 		cmpopts.IgnoreUnexported(privatepb.Person{}),
+		cmpopts.IgnoreUnexported(publicpb.Person_Address{}),
+		cmpopts.IgnoreUnexported(privatepb.Person_Address{}),
 		cmpopts.IgnoreUnexported(publicpb.Hobby{}),
 		cmpopts.IgnoreUnexported(privatepb.Hobby{}),
 		cmpopts.IgnoreUnexported(publicpb.Coding{}),
@@ -470,6 +755,857 @@ func ignore() []cmp.Option {
 		cmpopts.IgnoreUnexported(privatepb.PingRequest{}),
 		cmpopts.IgnoreUnexported(publicpb.PingResponse{}),
 		cmpopts.IgnoreUnexported(privatepb.PingResponse{}),
+		cmpopts.IgnoreUnexported(publicpb.GetManyRequest{}),
+		cmpopts.IgnoreUnexported(publicpb.GetManyResponse{}),
+		cmpopts.IgnoreUnexported(publicpb.SearchRequest{}),
+		cmpopts.IgnoreUnexported(privatepb.SearchRequest{}),
+		cmpopts.IgnoreUnexported(publicpb.SearchResponse{}),
+		cmpopts.IgnoreUnexported(privatepb.SearchResponse{}),
 		cmpopts.IgnoreUnexported(exttimestamppb.Timestamp{}),
+		cmpopts.IgnoreUnexported(extwrapperspb.BytesValue{}),
+	}
+}
+
+// FuzzCreate converts random inputs through the service chain to a fake
+// private server returning random outputs. It fails when a conversion panics or
+// when converting the private input back to the public input changes a field
+// that is not deprecated and is matched through the chain. Inputs that are not
+// converted to the private service, such as invalid inputs, are skipped.
+func FuzzCreate(f *testing.F, options []service.Option) {
+	s := &fuzzServer{}
+	conn, cleanup := startServer(f, s, options, TransportBufconn, grpc.UnaryInterceptor(s.recoverPanic))
+	defer cleanup()
+
+	client := publicpb.NewPeopleClient(conn)
+	converters := newFuzzConverters(options)
+	for seed := int64(0); seed < fuzzSeeds; seed++ {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		r := rand.New(rand.NewSource(seed))
+		var publicIn publicpb.CreateRequest
+		var privateOut privatepb.CreateResponse
+		randomMessage(r, publicIn.ProtoReflect(), 0)
+		randomMessage(r, privateOut.ProtoReflect(), 0)
+		s.in, s.out, s.panic = nil, &privateOut, ""
+
+		_, err := client.Create(context.Background(), &publicIn)
+		if s.panic != "" {
+			t.Fatal(s.panic)
+		}
+
+		if s.in == nil {
+			t.Skipf("input was not converted to the private service: %v", err)
+		}
+
+		out, err := converters.toPublicCreateInput(s.in.(*privatepb.CreateRequest))
+		if err != nil {
+			t.Fatalf("failed to convert private input to public input: %v", err)
+		}
+
+		if diff := cmp.Diff(roundTrip(&publicIn), roundTrip(out), protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+}
+
+// FuzzGet converts random inputs through the service chain to a fake
+// private server returning random outputs. It fails when a conversion panics or
+// when converting the private input back to the public input changes a field
+// that is not deprecated and is matched through the chain. Inputs that are not
+// converted to the private service, such as invalid inputs, are skipped.
+func FuzzGet(f *testing.F, options []service.Option) {
+	s := &fuzzServer{}
+	conn, cleanup := startServer(f, s, options, TransportBufconn, grpc.UnaryInterceptor(s.recoverPanic))
+	defer cleanup()
+
+	client := publicpb.NewPeopleClient(conn)
+	converters := newFuzzConverters(options)
+	for seed := int64(0); seed < fuzzSeeds; seed++ {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		r := rand.New(rand.NewSource(seed))
+		var publicIn publicpb.GetRequest
+		var privateOut privatepb.FetchResponse
+		randomMessage(r, publicIn.ProtoReflect(), 0)
+		randomMessage(r, privateOut.ProtoReflect(), 0)
+		s.in, s.out, s.panic = nil, &privateOut, ""
+
+		_, err := client.Get(context.Background(), &publicIn)
+		if s.panic != "" {
+			t.Fatal(s.panic)
+		}
+
+		if s.in == nil {
+			t.Skipf("input was not converted to the private service: %v", err)
+		}
+
+		out, err := converters.toPublicGetInput(s.in.(*privatepb.FetchRequest))
+		if err != nil {
+			t.Fatalf("failed to convert private input to public input: %v", err)
+		}
+
+		if diff := cmp.Diff(roundTrip(&publicIn), roundTrip(out), protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+}
+
+// FuzzDelete converts random inputs through the service chain to a fake
+// private server returning random outputs. It fails when a conversion panics or
+// when converting the private input back to the public input changes a field
+// that is not deprecated and is matched through the chain. Inputs that are not
+// converted to the private service, such as invalid inputs, are skipped.
+func FuzzDelete(f *testing.F, options []service.Option) {
+	s := &fuzzServer{}
+	conn, cleanup := startServer(f, s, options, TransportBufconn, grpc.UnaryInterceptor(s.recoverPanic))
+	defer cleanup()
+
+	client := publicpb.NewPeopleClient(conn)
+	converters := newFuzzConverters(options)
+	for seed := int64(0); seed < fuzzSeeds; seed++ {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		r := rand.New(rand.NewSource(seed))
+		var publicIn publicpb.DeleteRequest
+		var privateOut privatepb.DeleteResponse
+		randomMessage(r, publicIn.ProtoReflect(), 0)
+		randomMessage(r, privateOut.ProtoReflect(), 0)
+		s.in, s.out, s.panic = nil, &privateOut, ""
+
+		_, err := client.Delete(context.Background(), &publicIn)
+		if s.panic != "" {
+			t.Fatal(s.panic)
+		}
+
+		if s.in == nil {
+			t.Skipf("input was not converted to the private service: %v", err)
+		}
+
+		out, err := converters.toPublicDeleteInput(s.in.(*privatepb.DeleteRequest))
+		if err != nil {
+			t.Fatalf("failed to convert private input to public input: %v", err)
+		}
+
+		if diff := cmp.Diff(roundTrip(&publicIn), roundTrip(out), protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+}
+
+// FuzzUpdate converts random inputs through the service chain to a fake
+// private server returning random outputs. It fails when a conversion panics or
+// when converting the private input back to the public input changes a field
+// that is not deprecated and is matched through the chain. Inputs that are not
+// converted to the private service, such as invalid inputs, are skipped.
+func FuzzUpdate(f *testing.F, options []service.Option) {
+	s := &fuzzServer{}
+	conn, cleanup := startServer(f, s, options, TransportBufconn, grpc.UnaryInterceptor(s.recoverPanic))
+	defer cleanup()
+
+	client := publicpb.NewPeopleClient(conn)
+	converters := newFuzzConverters(options)
+	for seed := int64(0); seed < fuzzSeeds; seed++ {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		r := rand.New(rand.NewSource(seed))
+		var publicIn publicpb.UpdateRequest
+		var privateOut privatepb.UpdateResponse
+		randomMessage(r, publicIn.ProtoReflect(), 0)
+		randomMessage(r, privateOut.ProtoReflect(), 0)
+		s.in, s.out, s.panic = nil, &privateOut, ""
+
+		_, err := client.Update(context.Background(), &publicIn)
+		if s.panic != "" {
+			t.Fatal(s.panic)
+		}
+
+		if s.in == nil {
+			t.Skipf("input was not converted to the private service: %v", err)
+		}
+
+		out, err := converters.toPublicUpdateInput(s.in.(*privatepb.UpdateRequest))
+		if err != nil {
+			t.Fatalf("failed to convert private input to public input: %v", err)
+		}
+
+		if diff := cmp.Diff(roundTrip(&publicIn), roundTrip(out), protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+}
+
+// FuzzBatch converts random inputs through the service chain to a fake
+// private server returning random outputs. It fails when a conversion panics or
+// when converting the private input back to the public input changes a field
+// that is not deprecated and is matched through the chain. Inputs that are not
+// converted to the private service, such as invalid inputs, are skipped.
+func FuzzBatch(f *testing.F, options []service.Option) {
+	s := &fuzzServer{}
+	conn, cleanup := startServer(f, s, options, TransportBufconn, grpc.UnaryInterceptor(s.recoverPanic))
+	defer cleanup()
+
+	client := publicpb.NewPeopleClient(conn)
+	converters := newFuzzConverters(options)
+	for seed := int64(0); seed < fuzzSeeds; seed++ {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		r := rand.New(rand.NewSource(seed))
+		var publicIn publicpb.BatchRequest
+		var privateOut privatepb.BatchResponse
+		randomMessage(r, publicIn.ProtoReflect(), 0)
+		randomMessage(r, privateOut.ProtoReflect(), 0)
+		s.in, s.out, s.panic = nil, &privateOut, ""
+
+		_, err := client.Batch(context.Background(), &publicIn)
+		if s.panic != "" {
+			t.Fatal(s.panic)
+		}
+
+		if s.in == nil {
+			t.Skipf("input was not converted to the private service: %v", err)
+		}
+
+		out, err := converters.toPublicBatchInput(s.in.(*privatepb.BatchRequest))
+		if err != nil {
+			t.Fatalf("failed to convert private input to public input: %v", err)
+		}
+
+		if diff := cmp.Diff(roundTrip(&publicIn), roundTrip(out), protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+}
+
+// FuzzPing converts random inputs through the service chain to a fake
+// private server returning random outputs. It fails when a conversion panics or
+// when converting the private input back to the public input changes a field
+// that is not deprecated and is matched through the chain. Inputs that are not
+// converted to the private service, such as invalid inputs, are skipped.
+func FuzzPing(f *testing.F, options []service.Option) {
+	s := &fuzzServer{}
+	conn, cleanup := startServer(f, s, options, TransportBufconn, grpc.UnaryInterceptor(s.recoverPanic))
+	defer cleanup()
+
+	client := publicpb.NewPeopleClient(conn)
+	converters := newFuzzConverters(options)
+	for seed := int64(0); seed < fuzzSeeds; seed++ {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		r := rand.New(rand.NewSource(seed))
+		var publicIn publicpb.PingRequest
+		var privateOut privatepb.PingResponse
+		randomMessage(r, publicIn.ProtoReflect(), 0)
+		randomMessage(r, privateOut.ProtoReflect(), 0)
+		s.in, s.out, s.panic = nil, &privateOut, ""
+
+		_, err := client.Ping(context.Background(), &publicIn)
+		if s.panic != "" {
+			t.Fatal(s.panic)
+		}
+
+		if s.in == nil {
+			t.Skipf("input was not converted to the private service: %v", err)
+		}
+
+		out, err := converters.toPublicPingInput(s.in.(*privatepb.PingRequest))
+		if err != nil {
+			t.Fatalf("failed to convert private input to public input: %v", err)
+		}
+
+		if diff := cmp.Diff(roundTrip(&publicIn), roundTrip(out), protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+}
+
+// FuzzSearch converts random inputs through the service chain to a fake
+// private server returning random outputs. It fails when a conversion panics or
+// when converting the private input back to the public input changes a field
+// that is not deprecated and is matched through the chain. Inputs that are not
+// converted to the private service, such as invalid inputs, are skipped.
+func FuzzSearch(f *testing.F, options []service.Option) {
+	s := &fuzzServer{}
+	conn, cleanup := startServer(f, s, options, TransportBufconn, grpc.UnaryInterceptor(s.recoverPanic))
+	defer cleanup()
+
+	client := publicpb.NewPeopleClient(conn)
+	converters := newFuzzConverters(options)
+	for seed := int64(0); seed < fuzzSeeds; seed++ {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		r := rand.New(rand.NewSource(seed))
+		var publicIn publicpb.SearchRequest
+		var privateOut privatepb.SearchResponse
+		randomMessage(r, publicIn.ProtoReflect(), 0)
+		randomMessage(r, privateOut.ProtoReflect(), 0)
+		s.in, s.out, s.panic = nil, &privateOut, ""
+
+		_, err := client.Search(context.Background(), &publicIn)
+		if s.panic != "" {
+			t.Fatal(s.panic)
+		}
+
+		if s.in == nil {
+			t.Skipf("input was not converted to the private service: %v", err)
+		}
+
+		out, err := converters.toPublicSearchInput(s.in.(*privatepb.SearchRequest))
+		if err != nil {
+			t.Fatalf("failed to convert private input to public input: %v", err)
+		}
+
+		if diff := cmp.Diff(roundTrip(&publicIn), roundTrip(out), protocmp.Transform()); diff != "" {
+			t.Fatal(diff)
+		}
+	})
+}
+
+// fuzzSeeds is the number of seeds added to the corpus of each fuzz test.
+const fuzzSeeds = 8
+
+// fuzzDepth is the depth of nested messages populated with random values.
+const fuzzDepth = 3
+
+type fuzzServer struct {
+	privatepb.PeopleServer
+	mu    sync.Mutex
+	in    proto.Message
+	out   proto.Message
+	panic string
+}
+
+func (s *fuzzServer) Create(_ context.Context, in *privatepb.CreateRequest) (*privatepb.CreateResponse, error) {
+	s.in = in
+	return s.out.(*privatepb.CreateResponse), nil
+}
+
+func (s *fuzzServer) Fetch(_ context.Context, in *privatepb.FetchRequest) (*privatepb.FetchResponse, error) {
+	s.in = in
+	return s.out.(*privatepb.FetchResponse), nil
+}
+
+func (s *fuzzServer) Delete(_ context.Context, in *privatepb.DeleteRequest) (*privatepb.DeleteResponse, error) {
+	s.in = in
+	return s.out.(*privatepb.DeleteResponse), nil
+}
+
+func (s *fuzzServer) Update(_ context.Context, in *privatepb.UpdateRequest) (*privatepb.UpdateResponse, error) {
+	s.in = in
+	return s.out.(*privatepb.UpdateResponse), nil
+}
+
+func (s *fuzzServer) Batch(_ context.Context, in *privatepb.BatchRequest) (*privatepb.BatchResponse, error) {
+	s.in = in
+	return s.out.(*privatepb.BatchResponse), nil
+}
+
+func (s *fuzzServer) Ping(_ context.Context, in *privatepb.PingRequest) (*privatepb.PingResponse, error) {
+	s.in = in
+	return s.out.(*privatepb.PingResponse), nil
+}
+
+func (s *fuzzServer) Search(_ context.Context, in *privatepb.SearchRequest) (*privatepb.SearchResponse, error) {
+	s.in = in
+	return s.out.(*privatepb.SearchResponse), nil
+}
+
+// recoverPanic records a panic of a method of the service chain.
+func (s *fuzzServer) recoverPanic(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (out interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.panic = fmt.Sprintf("%s panicked: %v\n%s", info.FullMethod, r, debug.Stack())
+			err = status.Error(codes.Internal, s.panic)
+		}
+	}()
+
+	return handler(ctx, req)
+}
+
+// fuzzConverters convert private inputs to public inputs through each service
+// version of the chain.
+type fuzzConverters struct {
+	v2 v2svc.Converter
+}
+
+func newFuzzConverters(options []service.Option) fuzzConverters {
+	c := fuzzConverters{
+		v2: v2svc.NewConverter(),
+	}
+
+	for _, opt := range options {
+		switch opt.Name() {
+		case v2svc.ConverterName:
+			c.v2 = opt.(v2svc.Converter)
+		}
+	}
+
+	return c
+}
+
+func (c fuzzConverters) toPublicCreateInput(in *privatepb.CreateRequest) (*publicpb.CreateRequest, error) {
+	v2In, err := c.v2.ToPublicCreateRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return v2In, nil
+}
+
+func (c fuzzConverters) toPublicGetInput(in *privatepb.FetchRequest) (*publicpb.GetRequest, error) {
+	v2In, err := c.v2.ToPublicGetRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return v2In, nil
+}
+
+func (c fuzzConverters) toPublicDeleteInput(in *privatepb.DeleteRequest) (*publicpb.DeleteRequest, error) {
+	v2In, err := c.v2.ToPublicDeleteRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return v2In, nil
+}
+
+func (c fuzzConverters) toPublicUpdateInput(in *privatepb.UpdateRequest) (*publicpb.UpdateRequest, error) {
+	v2In, err := c.v2.ToPublicUpdateRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return v2In, nil
+}
+
+func (c fuzzConverters) toPublicBatchInput(in *privatepb.BatchRequest) (*publicpb.BatchRequest, error) {
+	v2In, err := c.v2.ToPublicBatchRequest(in)
+	if err != nil {
+		return nil, err
 	}
+
+	return v2In, nil
+}
+
+func (c fuzzConverters) toPublicPingInput(in *privatepb.PingRequest) (*publicpb.PingRequest, error) {
+	v2In, err := c.v2.ToPublicPingRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return v2In, nil
+}
+
+func (c fuzzConverters) toPublicSearchInput(in *privatepb.SearchRequest) (*publicpb.SearchRequest, error) {
+	v2In, err := c.v2.ToPublicSearchRequest(in)
+	if err != nil {
+		return nil, err
+	}
+
+	return v2In, nil
+}
+
+type fuzzRule struct {
+	Required bool
+	HasMin   bool
+	Min      float64
+	HasMax   bool
+	Max      float64
+	Is       string
+	In       []string
+	Builtin  string
+}
+
+// fuzzRules are the validation rules, and builtin conversions of string fields,
+// of the public fields random values must satisfy, keyed by field full name.
+var fuzzRules = map[protoreflect.FullName]fuzzRule{
+	"example.v2.Person.id":                {Required: true, Is: "UUID"},
+	"example.v2.Person.full_name":         {Required: true, HasMin: true, Min: 5},
+	"example.v2.Person.age":               {Required: true, HasMin: true, Min: 16},
+	"example.v2.Person.hobby":             {Required: true},
+	"example.v2.Hobby.type":               {Required: true},
+	"example.v2.CreateRequest.id":         {Required: true, Is: "UUID"},
+	"example.v2.CreateRequest.full_name":  {Required: true, HasMin: true, Min: 5},
+	"example.v2.CreateRequest.age":        {Required: true, HasMin: true, Min: 16},
+	"example.v2.CreateRequest.employment": {Required: true},
+	"example.v2.CreateRequest.hobby":      {Required: true},
+	"example.v2.GetRequest.id":            {Required: true, Is: "UUID"},
+	"example.v2.UpdateRequest.id":         {Required: true, Is: "UUID"},
+	"example.v2.UpdateRequest.person":     {Required: true},
+}
+
+// fuzzRoundTripFields are the public fields compared after converting a
+// private input back to a public input. Fields set to true are compared. Fields
+// set to false hold messages whose fields are compared. Fields that are not
+// compared are listed in comments with the reason.
+var fuzzRoundTripFields = map[protoreflect.FullName]bool{
+	"example.v2.Person.id":        true,
+	"example.v2.Person.full_name": true,
+	"example.v2.Person.age":       true,
+	// Not compared: example.v2.Person.employment has enum values received by other names.
+	"example.v2.Person.created_at": true,
+	"example.v2.Person.updated_at": true,
+	"example.v2.Person.hobby":      false,
+	"example.v2.Person.nickname":   true,
+	"example.v2.Person.address":    false,
+	// Not compared: example.v2.Person.photo tracks presence differently.
+	// Not compared: example.v2.Person.previous_employment has enum values received by other names.
+	"example.v2.Person.Address.city":     true,
+	"example.v2.Hobby.coding":            false,
+	"example.v2.Hobby.reading":           false,
+	"example.v2.Hobby.cycling":           false,
+	"example.v2.Coding.language":         true,
+	"example.v2.Reading.genre":           true,
+	"example.v2.Cycling.style":           true,
+	"example.v2.CreateRequest.id":        true,
+	"example.v2.CreateRequest.full_name": true,
+	"example.v2.CreateRequest.age":       true,
+	// Not compared: example.v2.CreateRequest.employment has enum values received by other names.
+	"example.v2.CreateRequest.hobby":    false,
+	"example.v2.CreateRequest.nickname": true,
+	"example.v2.CreateResponse.person":  false,
+	"example.v2.GetRequest.id":          true,
+	"example.v2.GetResponse.person":     false,
+	"example.v2.DeleteRequest.id":       true,
+	"example.v2.UpdateRequest.id":       true,
+	"example.v2.UpdateRequest.person":   false,
+	"example.v2.UpdateResponse.person":  false,
+	"example.v2.BatchRequest.creates":   false,
+	"example.v2.BatchResponse.people":   false,
+	"example.v2.SearchRequest.query":    true,
+	"example.v2.SearchResponse.people":  false,
+}
+
+// roundTrip returns a copy of a public message holding only the fields compared
+// by fuzz tests.
+func roundTrip(m proto.Message) proto.Message {
+	m = proto.Clone(m)
+	pruneRoundTrip(m.ProtoReflect())
+	return m
+}
+
+func pruneRoundTrip(m protoreflect.Message) {
+	var cleared []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		compare, ok := fuzzRoundTripFields[fd.FullName()]
+		switch {
+		case !ok:
+			cleared = append(cleared, fd)
+		case compare:
+		case fd.IsList():
+			for i := 0; i < v.List().Len(); i++ {
+				pruneRoundTrip(v.List().Get(i).Message())
+			}
+		case fd.IsMap():
+			v.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				pruneRoundTrip(v.Message())
+				return true
+			})
+		default:
+			pruneRoundTrip(v.Message())
+		}
+
+		return true
+	})
+
+	for _, fd := range cleared {
+		m.Clear(fd)
+	}
+}
+
+// randomMessage populates a message with random values satisfying the fuzz
+// rules of its fields. Well-known messages are populated with valid values,
+// or left empty when they hold arbitrary values.
+func randomMessage(r *rand.Rand, m protoreflect.Message, depth int) {
+	fields := m.Descriptor().Fields()
+	switch m.Descriptor().FullName() {
+	case "google.protobuf.Timestamp":
+		m.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(r.Int63n(4102444800)))
+		m.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(r.Int31n(1e9)))
+		return
+	case "google.protobuf.Duration":
+		m.Set(fields.ByName("seconds"), protoreflect.ValueOfInt64(r.Int63n(1e6)))
+		m.Set(fields.ByName("nanos"), protoreflect.ValueOfInt32(r.Int31n(1e9)))
+		return
+	case "google.protobuf.Any", "google.protobuf.Struct", "google.protobuf.Value", "google.protobuf.ListValue", "google.protobuf.FieldMask":
+		return
+	}
+
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			continue
+		}
+
+		rule := fuzzRules[fd.FullName()]
+		if !rule.Required && r.Intn(2) == 0 {
+			continue
+		}
+
+		randomField(r, m, fd, rule, depth)
+	}
+
+	oneofs := m.Descriptor().Oneofs()
+	for i := 0; i < oneofs.Len(); i++ {
+		oneof := oneofs.Get(i)
+		if oneof.IsSynthetic() || (!fuzzRules[oneof.FullName()].Required && r.Intn(2) == 0) {
+			continue
+		}
+
+		fd := oneof.Fields().Get(r.Intn(oneof.Fields().Len()))
+		randomField(r, m, fd, fuzzRules[fd.FullName()], depth)
+	}
+}
+
+func randomField(r *rand.Rand, m protoreflect.Message, fd protoreflect.FieldDescriptor, rule fuzzRule, depth int) {
+	// Nested messages are left unset past the fuzz depth to end recursion.
+	isMessage := fd.Message() != nil && (!fd.IsMap() || fd.MapValue().Message() != nil)
+	if isMessage && depth >= fuzzDepth {
+		return
+	}
+
+	switch {
+	case fd.IsList():
+		list := m.Mutable(fd).List()
+		for n := randomLength(r, rule, 3); n > 0; n-- {
+			if isMessage {
+				v := list.NewElement()
+				randomMessage(r, v.Message(), depth+1)
+				list.Append(v)
+			} else {
+				list.Append(randomValue(r, fd, fuzzRule{}))
+			}
+		}
+	case fd.IsMap():
+		mp := m.Mutable(fd).Map()
+		for n := randomLength(r, rule, 3); n > 0; n-- {
+			key := randomValue(r, fd.MapKey(), fuzzRule{}).MapKey()
+			if isMessage {
+				v := mp.NewValue()
+				randomMessage(r, v.Message(), depth+1)
+				mp.Set(key, v)
+			} else {
+				mp.Set(key, randomValue(r, fd.MapValue(), fuzzRule{}))
+			}
+		}
+	case isMessage:
+		randomMessage(r, m.Mutable(fd).Message(), depth+1)
+	default:
+		m.Set(fd, randomValue(r, fd, rule))
+	}
+}
+
+// randomValue returns a random scalar value of a field satisfying its fuzz
+// rule. Minimums and maximums are lengths of strings and bytes.
+func randomValue(r *rand.Rand, fd protoreflect.FieldDescriptor, rule fuzzRule) protoreflect.Value {
+	if len(rule.In) > 0 {
+		if v, ok := parseValue(fd, rule.In[r.Intn(len(rule.In))]); ok {
+			return v
+		}
+	}
+
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(rule.Required || r.Intn(2) == 0)
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		v := values.Get(r.Intn(values.Len()))
+		if rule.Required && v.Number() == 0 && values.Len() > 1 {
+			v = values.Get(1 + r.Intn(values.Len()-1))
+		}
+		return protoreflect.ValueOfEnum(v.Number())
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(randomInt(r, rule, -1e6, 1e6)))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(randomInt(r, rule, -1e12, 1e12))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(randomInt(r, rule, 0, 1e6)))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(uint64(randomInt(r, rule, 0, 1e12)))
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(float32(randomFloat(r, rule)))
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(randomFloat(r, rule))
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(randomString(r, rule)))
+	default:
+		return protoreflect.ValueOfString(randomString(r, rule))
+	}
+}
+
+// parseValue parses a value of the `in` validation rule of a field.
+func parseValue(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, bool) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), true
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(b), err == nil
+	case protoreflect.EnumKind:
+		if v := fd.Enum().Values().ByName(protoreflect.Name(s)); v != nil {
+			return protoreflect.ValueOfEnum(v.Number()), true
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), err == nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err == nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(n), err == nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err == nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(n), err == nil
+	case protoreflect.FloatKind:
+		n, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(n)), err == nil
+	case protoreflect.DoubleKind:
+		n, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(n), err == nil
+	}
+
+	return protoreflect.Value{}, false
+}
+
+func randomInt(r *rand.Rand, rule fuzzRule, min, max int64) int64 {
+	if rule.HasMin {
+		min = int64(rule.Min)
+	}
+
+	if rule.HasMax {
+		max = int64(rule.Max)
+	}
+
+	if max < min {
+		max = min
+	}
+
+	n := min + r.Int63n(max-min+1)
+	if n == 0 && rule.Required {
+		if max > 0 {
+			return max
+		}
+		return min
+	}
+
+	return n
+}
+
+func randomFloat(r *rand.Rand, rule fuzzRule) float64 {
+	min, max := -1e6, 1e6
+	if rule.HasMin {
+		min = rule.Min
+	}
+
+	if rule.HasMax {
+		max = rule.Max
+	}
+
+	if max < min {
+		max = min
+	}
+
+	n := min + r.Float64()*(max-min)
+	if n == 0 && rule.Required {
+		return max
+	}
+
+	return n
+}
+
+// randomLength returns a random length between the minimum and maximum of a
+// fuzz rule, or up to `max` when the rule has no maximum.
+func randomLength(r *rand.Rand, rule fuzzRule, max int) int {
+	min := 0
+	if rule.HasMin {
+		min = int(rule.Min)
+	}
+
+	if rule.Required && min < 1 {
+		min = 1
+	}
+
+	if rule.HasMax {
+		max = int(rule.Max)
+	} else if max < min {
+		max = min
+	}
+
+	if max <= min {
+		return min
+	}
+
+	return min + r.Intn(max-min+1)
+}
+
+// randomString returns a random string satisfying the format, or builtin
+// conversion, of a fuzz rule.
+func randomString(r *rand.Rand, rule fuzzRule) string {
+	const letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	switch rule.Builtin {
+	case "INTEGER":
+		return strconv.FormatInt(randomInt(r, rule, 0, 1000), 10)
+	case "RFC3339":
+		return time.Unix(r.Int63n(4102444800), 0).UTC().Format(time.RFC3339)
+	}
+
+	b := make([]byte, randomLength(r, rule, 16))
+	for i := range b {
+		b[i] = letters[r.Intn(len(letters))]
+	}
+	s := string(b)
+
+	switch rule.Is {
+	case "UUID":
+		u := make([]byte, 16)
+		r.Read(u)
+		u[6] = (u[6] & 0x0f) | 0x40
+		u[8] = (u[8] & 0x3f) | 0x80
+		return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:])
+	case "EMAIL":
+		return "a" + s + "@example.com"
+	case "URL":
+		return "https://example.com/" + s
+	}
+
+	return s
 }
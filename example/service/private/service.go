@@ -2,7 +2,12 @@ package private
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"log"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 
 	"google.golang.org/grpc/codes"
@@ -12,6 +17,8 @@ import (
 	privatepb "github.com/dane/protoc-gen-go-svc/example/proto/go/private"
 )
 
+const defaultPageSize = 25
+
 type Service struct {
 	privatepb.PeopleServer
 	mu    sync.RWMutex
@@ -30,6 +37,7 @@ func (s *Service) Create(ctx context.Context, req *privatepb.CreateRequest) (*pr
 		Age:        req.Age,
 		Employment: req.Employment,
 		Hobby:      req.Hobby,
+		Nickname:   req.Nickname,
 		CreatedAt:  timestamppb.Now(),
 		UpdatedAt:  timestamppb.Now(),
 	}
@@ -68,6 +76,11 @@ func (s *Service) List(ctx context.Context, req *privatepb.ListRequest) (*privat
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
+	offset, err := decodePageToken(req.PageToken)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page token")
+	}
+
 	var people []*privatepb.Person
 	for _, person := range s.Store {
 		if person.DeletedAt != nil {
@@ -77,7 +90,65 @@ func (s *Service) List(ctx context.Context, req *privatepb.ListRequest) (*privat
 		people = append(people, person)
 	}
 
-	return &privatepb.ListResponse{People: people}, nil
+	sort.Slice(people, func(i, j int) bool {
+		return people[i].Id < people[j].Id
+	})
+
+	size := int(req.PageSize)
+	if size <= 0 {
+		size = defaultPageSize
+	}
+
+	if offset > len(people) {
+		offset = len(people)
+	}
+
+	end := offset + size
+	if end > len(people) {
+		end = len(people)
+	}
+
+	res := &privatepb.ListResponse{People: people[offset:end]}
+	if end < len(people) {
+		res.NextPageToken = encodePageToken(end)
+	}
+
+	return res, nil
+}
+
+func (s *Service) Search(ctx context.Context, req *privatepb.SearchRequest) (*privatepb.SearchResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var people []*privatepb.Person
+	for _, person := range s.Store {
+		if person.DeletedAt != nil || !strings.Contains(person.FullName, req.Query) {
+			continue
+		}
+
+		people = append(people, person)
+	}
+
+	sort.Slice(people, func(i, j int) bool {
+		return people[i].Id < people[j].Id
+	})
+
+	size := int(req.Limit)
+	if size <= 0 {
+		size = defaultPageSize
+	}
+
+	offset := int(req.Offset)
+	if offset > len(people) {
+		offset = len(people)
+	}
+
+	end := offset + size
+	if end > len(people) {
+		end = len(people)
+	}
+
+	return &privatepb.SearchResponse{People: people[offset:end]}, nil
 }
 
 func (s *Service) Batch(ctx context.Context, req *privatepb.BatchRequest) (*privatepb.BatchResponse, error) {
@@ -91,3 +162,27 @@ func (s *Service) Batch(ctx context.Context, req *privatepb.BatchRequest) (*priv
 	}
 	return &privatepb.BatchResponse{People: people}, nil
 }
+
+// encodePageToken matches the default page token codec of the public services
+// so offsets of deprecated methods can be converted to page tokens.
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodePageToken(token string) (int, error) {
+	if token == "" {
+		return 0, nil
+	}
+
+	value, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+
+	offset, err := strconv.Atoi(string(value))
+	if err != nil || offset < 0 {
+		return 0, errors.New("invalid offset")
+	}
+
+	return offset, nil
+}
@@ -77,6 +77,118 @@ func (Validate_IsType) EnumDescriptor() ([]byte, []int) {
 	return file_annotations_proto_rawDescGZIP(), []int{9, 0}
 }
 
+type Pagination_Style int32
+
+const (
+	// UNSPECIFIED should not be used.
+	Pagination_UNSPECIFIED Pagination_Style = 0
+	// TOKEN pages through results with the `page_size` and `page_token` input
+	// fields and the `next_page_token` output field.
+	Pagination_TOKEN Pagination_Style = 1
+	// OFFSET pages through results with the `offset` and `limit` input fields.
+	Pagination_OFFSET Pagination_Style = 2
+)
+
+// Enum value maps for Pagination_Style.
+var (
+	Pagination_Style_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "TOKEN",
+		2: "OFFSET",
+	}
+	Pagination_Style_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"TOKEN":       1,
+		"OFFSET":      2,
+	}
+)
+
+func (x Pagination_Style) Enum() *Pagination_Style {
+	p := new(Pagination_Style)
+	*p = x
+	return p
+}
+
+func (x Pagination_Style) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Pagination_Style) Descriptor() protoreflect.EnumDescriptor {
+	return file_annotations_proto_enumTypes[1].Descriptor()
+}
+
+func (Pagination_Style) Type() protoreflect.EnumType {
+	return &file_annotations_proto_enumTypes[1]
+}
+
+func (x Pagination_Style) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Pagination_Style.Descriptor instead.
+func (Pagination_Style) EnumDescriptor() ([]byte, []int) {
+	return file_annotations_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type Convert_Builtin int32
+
+const (
+	// UNSPECIFIED should not be used.
+	Convert_UNSPECIFIED Convert_Builtin = 0
+	// INTEGER converts between a string and an integer. An empty string and
+	// zero are converted to each other.
+	Convert_INTEGER Convert_Builtin = 1
+	// RFC3339 converts between a string and a `google.protobuf.Timestamp`
+	// formatted as RFC 3339.
+	Convert_RFC3339 Convert_Builtin = 2
+	// SECONDS converts between an integer of seconds and a
+	// `google.protobuf.Duration`.
+	Convert_SECONDS Convert_Builtin = 3
+)
+
+// Enum value maps for Convert_Builtin.
+var (
+	Convert_Builtin_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "INTEGER",
+		2: "RFC3339",
+		3: "SECONDS",
+	}
+	Convert_Builtin_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"INTEGER":     1,
+		"RFC3339":     2,
+		"SECONDS":     3,
+	}
+)
+
+func (x Convert_Builtin) Enum() *Convert_Builtin {
+	p := new(Convert_Builtin)
+	*p = x
+	return p
+}
+
+func (x Convert_Builtin) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Convert_Builtin) Descriptor() protoreflect.EnumDescriptor {
+	return file_annotations_proto_enumTypes[2].Descriptor()
+}
+
+func (Convert_Builtin) Type() protoreflect.EnumType {
+	return &file_annotations_proto_enumTypes[2]
+}
+
+func (x Convert_Builtin) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Convert_Builtin.Descriptor instead.
+func (Convert_Builtin) EnumDescriptor() ([]byte, []int) {
+	return file_annotations_proto_rawDescGZIP(), []int{14, 0}
+}
+
 type MethodAnnotation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -93,6 +205,19 @@ type MethodAnnotation struct {
 	// but with nil return values only. This is intended for cases where either
 	// message is external.
 	Converter *Converter `protobuf:"bytes,3,opt,name=converter,proto3" json:"converter,omitempty"`
+	// pagination describes how the method pages through results. See
+	// documentation of `Pagination`.
+	Pagination *Pagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// hook generates a `<Method>Hook` interface for a deprecated method or a
+	// method of the latest service. The method calls the hook with the private
+	// input message and the private service rather than calling one private
+	// method. This allows one method to call a sequence of private methods.
+	Hook bool `protobuf:"varint,5,opt,name=hook,proto3" json:"hook,omitempty"`
+	// alias implements the method by calling another method of the same service
+	// for each item of a repeated input field. Alias methods do not call the
+	// next service version or the private service directly. See documentation
+	// of `Alias`.
+	Alias *Alias `protobuf:"bytes,6,opt,name=alias,proto3" json:"alias,omitempty"`
 }
 
 func (x *MethodAnnotation) Reset() {
@@ -148,6 +273,27 @@ func (x *MethodAnnotation) GetConverter() *Converter {
 	return nil
 }
 
+func (x *MethodAnnotation) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *MethodAnnotation) GetHook() bool {
+	if x != nil {
+		return x.Hook
+	}
+	return false
+}
+
+func (x *MethodAnnotation) GetAlias() *Alias {
+	if x != nil {
+		return x.Alias
+	}
+	return nil
+}
+
 type MessageAnnotation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -164,6 +310,9 @@ type MessageAnnotation struct {
 	// generates the `Converter` method, but with nil return values only. This is
 	// intended for cases where the conversion cannot be automated.
 	Converter *Converter `protobuf:"bytes,3,opt,name=converter,proto3" json:"converter,omitempty"`
+	// compose is a list of fields composed into a single field of the message of
+	// the next service version. See documentation of `Compose`.
+	Compose []*Compose `protobuf:"bytes,4,rep,name=compose,proto3" json:"compose,omitempty"`
 }
 
 func (x *MessageAnnotation) Reset() {
@@ -219,6 +368,13 @@ func (x *MessageAnnotation) GetConverter() *Converter {
 	return nil
 }
 
+func (x *MessageAnnotation) GetCompose() []*Compose {
+	if x != nil {
+		return x.Compose
+	}
+	return nil
+}
+
 type FieldAnnotation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -239,6 +395,10 @@ type FieldAnnotation struct {
 	// service version. Deprecated fields must be present on the message of the
 	// private service.
 	Deprecated bool `protobuf:"varint,5,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// convert names the conversion between the field and the field of the next
+	// service version or the private service when their types differ. See
+	// documentation of `Convert`.
+	Convert *Convert `protobuf:"bytes,6,opt,name=convert,proto3" json:"convert,omitempty"`
 }
 
 func (x *FieldAnnotation) Reset() {
@@ -301,6 +461,13 @@ func (x *FieldAnnotation) GetDeprecated() bool {
 	return false
 }
 
+func (x *FieldAnnotation) GetConvert() *Convert {
+	if x != nil {
+		return x.Convert
+	}
+	return nil
+}
+
 type EnumAnnotation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -883,6 +1050,312 @@ func (*Number_Double) isNumber_Value() {}
 
 func (*Number_Uint64) isNumber_Value() {}
 
+type Pagination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// style identifies the fields used to page through results. The style may
+	// differ between service versions and is converted when a method calls the
+	// next service version or the private service.
+	Style Pagination_Style `protobuf:"varint,1,opt,name=style,proto3,enum=gen.svc.Pagination_Style" json:"style,omitempty"`
+	// max_page_size ensures the `page_size` or `limit` field value is not greater
+	// than the value. A `page_size` or `limit` of zero is passed on unchanged and
+	// requests the default page size of the called method.
+	MaxPageSize int32 `protobuf:"varint,2,opt,name=max_page_size,json=maxPageSize,proto3" json:"max_page_size,omitempty"`
+	// results is the name of the repeated output field holding a page of results.
+	// It is required when a `TOKEN` method calls an `OFFSET` method. The next
+	// page token is only populated when a full page of results is returned. When
+	// the page size is zero, the size of a full page is not known, so the next
+	// page token is populated when any results are returned.
+	Results string `protobuf:"bytes,3,opt,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_annotations_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_annotations_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_annotations_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Pagination) GetStyle() Pagination_Style {
+	if x != nil {
+		return x.Style
+	}
+	return Pagination_UNSPECIFIED
+}
+
+func (x *Pagination) GetMaxPageSize() int32 {
+	if x != nil {
+		return x.MaxPageSize
+	}
+	return 0
+}
+
+func (x *Pagination) GetResults() string {
+	if x != nil {
+		return x.Results
+	}
+	return ""
+}
+
+type Alias struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// method is the name of the method of the same service called for each item
+	// of the `input` field.
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// input is the name of the repeated input field holding the inputs of
+	// `method`.
+	Input string `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	// output is the name of the repeated output field collecting the outputs of
+	// `method`. Outputs are in the same order as the inputs.
+	Output string `protobuf:"bytes,3,opt,name=output,proto3" json:"output,omitempty"`
+	// concurrency limits the number of calls of `method` running at once. All
+	// calls run at once when unset.
+	Concurrency uint32 `protobuf:"varint,4,opt,name=concurrency,proto3" json:"concurrency,omitempty"`
+}
+
+func (x *Alias) Reset() {
+	*x = Alias{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_annotations_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Alias) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alias) ProtoMessage() {}
+
+func (x *Alias) ProtoReflect() protoreflect.Message {
+	mi := &file_annotations_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alias.ProtoReflect.Descriptor instead.
+func (*Alias) Descriptor() ([]byte, []int) {
+	return file_annotations_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Alias) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Alias) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *Alias) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *Alias) GetConcurrency() uint32 {
+	if x != nil {
+		return x.Concurrency
+	}
+	return 0
+}
+
+type Convert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// builtin is a conversion provided by the generator. The type of the field
+	// and the type of the target field determine the direction of the
+	// conversion.
+	Builtin Convert_Builtin `protobuf:"varint,1,opt,name=builtin,proto3,enum=gen.svc.Convert_Builtin" json:"builtin,omitempty"`
+	// func is the name of a user defined conversion. A `FieldConverters` option
+	// is generated with `{func}ToNext`, `{func}FromNext`, `{func}ToPrivate`, and
+	// `{func}FromPrivate` functions as required by the service version. The
+	// functions are registered by passing `FieldConverters` to `RegisterServer`.
+	Func string `protobuf:"bytes,2,opt,name=func,proto3" json:"func,omitempty"`
+}
+
+func (x *Convert) Reset() {
+	*x = Convert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_annotations_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Convert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Convert) ProtoMessage() {}
+
+func (x *Convert) ProtoReflect() protoreflect.Message {
+	mi := &file_annotations_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Convert.ProtoReflect.Descriptor instead.
+func (*Convert) Descriptor() ([]byte, []int) {
+	return file_annotations_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Convert) GetBuiltin() Convert_Builtin {
+	if x != nil {
+		return x.Builtin
+	}
+	return Convert_UNSPECIFIED
+}
+
+func (x *Convert) GetFunc() string {
+	if x != nil {
+		return x.Func
+	}
+	return ""
+}
+
+type Compose struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// fields are the names of the fields composed into the `into` field, in
+	// order. Fields that are not deprecated are not present in the message of
+	// the next service version. They are split from the `into` field when
+	// converting from the next service version.
+	Fields []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	// into is the name of the field of the message of the next service version.
+	Into string `protobuf:"bytes,2,opt,name=into,proto3" json:"into,omitempty"`
+	// separator joins string fields into the `into` field and splits the `into`
+	// field into string fields.
+	Separator string `protobuf:"bytes,3,opt,name=separator,proto3" json:"separator,omitempty"`
+	// format is a `fmt` format string the string fields are composed with. A
+	// format cannot split a field, so all fields must be deprecated.
+	Format string `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`
+	// func is the name of a user defined composition. A `FieldConverters` option
+	// is generated with a `{func}Compose` function and, if any field is not
+	// deprecated, a `{func}Split` function.
+	Func string `protobuf:"bytes,5,opt,name=func,proto3" json:"func,omitempty"`
+}
+
+func (x *Compose) Reset() {
+	*x = Compose{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_annotations_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Compose) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Compose) ProtoMessage() {}
+
+func (x *Compose) ProtoReflect() protoreflect.Message {
+	mi := &file_annotations_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Compose.ProtoReflect.Descriptor instead.
+func (*Compose) Descriptor() ([]byte, []int) {
+	return file_annotations_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Compose) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *Compose) GetInto() string {
+	if x != nil {
+		return x.Into
+	}
+	return ""
+}
+
+func (x *Compose) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *Compose) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Compose) GetFunc() string {
+	if x != nil {
+		return x.Func
+	}
+	return ""
+}
+
 type Converter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -896,7 +1369,7 @@ type Converter struct {
 func (x *Converter) Reset() {
 	*x = Converter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_annotations_proto_msgTypes[12]
+		mi := &file_annotations_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -909,7 +1382,7 @@ func (x *Converter) String() string {
 func (*Converter) ProtoMessage() {}
 
 func (x *Converter) ProtoReflect() protoreflect.Message {
-	mi := &file_annotations_proto_msgTypes[12]
+	mi := &file_annotations_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1395,7 @@ func (x *Converter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Converter.ProtoReflect.Descriptor instead.
 func (*Converter) Descriptor() ([]byte, []int) {
-	return file_annotations_proto_rawDescGZIP(), []int{12}
+	return file_annotations_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *Converter) GetEmpty() bool {
@@ -941,6 +1414,22 @@ var file_annotations_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,1140,opt,name=go_package",
 		Filename:      "annotations.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         1141,
+		Name:          "gen.svc.private_package",
+		Tag:           "bytes,1141,opt,name=private_package",
+		Filename:      "annotations.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         1142,
+		Name:          "gen.svc.next_package",
+		Tag:           "bytes,1142,opt,name=next_package",
+		Filename:      "annotations.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
 		ExtensionType: (*MethodAnnotation)(nil),
@@ -998,6 +1487,20 @@ var (
 	//
 	// optional string go_package = 1140;
 	E_GoPackage = &file_annotations_proto_extTypes[0]
+	// private_package is the proto package of the private service called by the
+	// service version of the file. Files generated to different `go_package`
+	// locations form independent chains of service versions. Versions of a chain
+	// may call different private services, such as during a backend migration.
+	//
+	// optional string private_package = 1141;
+	E_PrivatePackage = &file_annotations_proto_extTypes[1]
+	// next_package is the proto package of the service version called by the
+	// service of the file, rather than the following version of the chain. This
+	// allows a branch version, such as `v2partner`, to call `v3` directly. Naming
+	// the private package makes the service call the private service directly.
+	//
+	// optional string next_package = 1142;
+	E_NextPackage = &file_annotations_proto_extTypes[2]
 )
 
 // Extension fields to descriptorpb.MethodOptions.
@@ -1005,7 +1508,7 @@ var (
 	// method see documentation of `MethodAnnotation`.
 	//
 	// optional gen.svc.MethodAnnotation method = 1140;
-	E_Method = &file_annotations_proto_extTypes[1]
+	E_Method = &file_annotations_proto_extTypes[3]
 )
 
 // Extension fields to descriptorpb.MessageOptions.
@@ -1013,7 +1516,7 @@ var (
 	// message see documentation of `MessageAnnotation`.
 	//
 	// optional gen.svc.MessageAnnotation message = 1140;
-	E_Message = &file_annotations_proto_extTypes[2]
+	E_Message = &file_annotations_proto_extTypes[4]
 )
 
 // Extension fields to descriptorpb.FieldOptions.
@@ -1021,7 +1524,7 @@ var (
 	// field see documentation of `FieldAnnotation`.
 	//
 	// optional gen.svc.FieldAnnotation field = 1140;
-	E_Field = &file_annotations_proto_extTypes[3]
+	E_Field = &file_annotations_proto_extTypes[5]
 )
 
 // Extension fields to descriptorpb.EnumOptions.
@@ -1029,7 +1532,7 @@ var (
 	// enum see documentation of `EnumAnnotation`.
 	//
 	// optional gen.svc.EnumAnnotation enum = 1140;
-	E_Enum = &file_annotations_proto_extTypes[4]
+	E_Enum = &file_annotations_proto_extTypes[6]
 )
 
 // Extension fields to descriptorpb.OneofOptions.
@@ -1037,7 +1540,7 @@ var (
 	// oneof see documentation of `OneofAnnotation`.
 	//
 	// optional gen.svc.OneofAnnotation oneof = 1140;
-	E_Oneof = &file_annotations_proto_extTypes[5]
+	E_Oneof = &file_annotations_proto_extTypes[7]
 )
 
 // Extension fields to descriptorpb.EnumValueOptions.
@@ -1045,7 +1548,7 @@ var (
 	// enum_value see documentation of `EnumValueAnnotation`.
 	//
 	// optional gen.svc.EnumValueAnnotation enum_value = 1140;
-	E_EnumValue = &file_annotations_proto_extTypes[6]
+	E_EnumValue = &file_annotations_proto_extTypes[8]
 )
 
 var File_annotations_proto protoreflect.FileDescriptor
@@ -1054,8 +1557,8 @@ var file_annotations_proto_rawDesc = []byte{
 	0x0a, 0x11, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x07, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x1a, 0x20, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x93,
-	0x01, 0x0a, 0x10, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x82,
+	0x02, 0x0a, 0x10, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e,
 	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
@@ -1064,124 +1567,181 @@ var file_annotations_proto_rawDesc = []byte{
 	0x65, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x18,
 	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e,
 	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x74, 0x65, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x12, 0x33, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73,
+	0x76, 0x63, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x6f,
+	0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x24, 0x0a,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x05, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x65,
+	0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x08,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72,
+	0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65,
+	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x65,
+	0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x52,
+	0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6f,
+	0x6d, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x65,
+	0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x52, 0x07, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x65, 0x22, 0xec, 0x01, 0x0a, 0x0f, 0x46, 0x69, 0x65, 0x6c, 0x64,
 	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x64, 0x65,
 	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67,
 	0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52,
-	0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70,
-	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64,
-	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x63, 0x6f, 0x6e,
-	0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
-	0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72,
-	0x52, 0x09, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x72, 0x22, 0xc0, 0x01, 0x0a, 0x0f,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2d, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x65, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x2f,
-	0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12,
-	0x2d, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x3f,
-	0x0a, 0x0e, 0x45, 0x6e, 0x75, 0x6d, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x2d, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x22,
-	0x70, 0x0a, 0x13, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73,
-	0x76, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x08, 0x64, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63,
-	0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76,
-	0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52, 0x07, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76,
-	0x63, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x73, 0x76, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52,
 	0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70,
 	0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64,
-	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x1e, 0x0a, 0x08, 0x44, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x33, 0x0a, 0x07, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x2a,
-	0x0a, 0x0c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x1a,
+	0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x74, 0x22, 0x3f, 0x0a, 0x0e, 0x45, 0x6e, 0x75, 0x6d, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x73, 0x76, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x08, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x22, 0x70, 0x0a, 0x13, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a,
+	0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x07,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x52,
+	0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x4f, 0x6e, 0x65,
+	0x6f, 0x66, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x08,
+	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x52, 0x07, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x32, 0x0a, 0x08,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x08, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x22, 0x1e, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x33, 0x0a, 0x07, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x2a, 0x0a, 0x0c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x22, 0xdf, 0x01, 0x0a, 0x08, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a,
 	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xdf, 0x01, 0x0a, 0x08, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69,
-	0x72, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x28, 0x0a, 0x02, 0x69, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52,
-	0x02, 0x69, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x6e, 0x22, 0x37, 0x0a, 0x06, 0x49, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a,
-	0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08,
-	0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x41, 0x49,
-	0x4c, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x52, 0x4c, 0x10, 0x03, 0x22, 0x2b, 0x0a, 0x0d,
-	0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x5d, 0x0a, 0x06, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x18, 0x0a, 0x06, 0x64,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x64,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42,
-	0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x21, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x76,
-	0x65, 0x72, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x3a, 0x3c, 0x0a, 0x0a, 0x67,
-	0x6f, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x67, 0x6f, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x3a, 0x52, 0x0a, 0x06, 0x6d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6e,
-	0x2e, 0x73, 0x76, 0x63, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x3a, 0x56, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x4e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf4, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x4a, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x1c, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x45, 0x6e, 0x75,
-	0x6d, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x65, 0x6e, 0x75,
-	0x6d, 0x3a, 0x4e, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65,
-	0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66,
-	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f,
-	0x66, 0x3a, 0x5f, 0x0a, 0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
-	0x73, 0x76, 0x63, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x64, 0x61, 0x6e, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
-	0x2d, 0x67, 0x6f, 0x2d, 0x73, 0x76, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x76, 0x63, 0x3b,
-	0x73, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x03, 0x6d, 0x69,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76,
+	0x63, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x21, 0x0a,
+	0x03, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x65, 0x6e,
+	0x2e, 0x73, 0x76, 0x63, 0x2e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x03, 0x6d, 0x61, 0x78,
+	0x12, 0x28, 0x0a, 0x02, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e,
+	0x49, 0x73, 0x54, 0x79, 0x70, 0x65, 0x52, 0x02, 0x69, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x22, 0x37, 0x0a, 0x06, 0x49, 0x73,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x10, 0x01, 0x12,
+	0x09, 0x0a, 0x05, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x55, 0x52,
+	0x4c, 0x10, 0x03, 0x22, 0x2b, 0x0a, 0x0d, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x22, 0x5d, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x05, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x12, 0x18, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x06,
+	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06,
+	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xac, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f,
+	0x0a, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x52, 0x05, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x12,
+	0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x2f, 0x0a,
+	0x05, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x4f, 0x4b, 0x45, 0x4e,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4f, 0x46, 0x46, 0x53, 0x45, 0x54, 0x10, 0x02, 0x22, 0x6f,
+	0x0a, 0x05, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22,
+	0x94, 0x01, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x62,
+	0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x67,
+	0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x52, 0x07, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x75, 0x6e, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66,
+	0x75, 0x6e, 0x63, 0x22, 0x41, 0x0a, 0x07, 0x42, 0x75, 0x69, 0x6c, 0x74, 0x69, 0x6e, 0x12, 0x0f,
+	0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x54, 0x45, 0x47, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x46, 0x43, 0x33, 0x33, 0x33, 0x39, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x43,
+	0x4f, 0x4e, 0x44, 0x53, 0x10, 0x03, 0x22, 0x7f, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x73,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x74,
+	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x6e, 0x74, 0x6f, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6e, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x66, 0x75, 0x6e, 0x63, 0x22, 0x21, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x3a, 0x3c, 0x0a, 0x0a, 0x67, 0x6f,
+	0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67,
+	0x6f, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x3a, 0x46, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf5, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x3a, 0x40, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf6,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x65, 0x3a, 0x52, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x3a, 0x56, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x73, 0x76, 0x63, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x4e,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x4a,
+	0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x65,
+	0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x3a, 0x4e, 0x0a, 0x05, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x65, 0x6e, 0x2e,
+	0x73, 0x76, 0x63, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3a, 0x5f, 0x0a, 0x0a, 0x65, 0x6e,
+	0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf4, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x65, 0x6e, 0x2e, 0x73, 0x76, 0x63, 0x2e, 0x45, 0x6e, 0x75,
+	0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x6e, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x73, 0x76, 0x63,
+	0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x76, 0x63, 0x3b, 0x73, 0x76, 0x63, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1196,66 +1756,80 @@ func file_annotations_proto_rawDescGZIP() []byte {
 	return file_annotations_proto_rawDescData
 }
 
-var file_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_annotations_proto_goTypes = []interface{}{
 	(Validate_IsType)(0),                  // 0: gen.svc.Validate.IsType
-	(*MethodAnnotation)(nil),              // 1: gen.svc.MethodAnnotation
-	(*MessageAnnotation)(nil),             // 2: gen.svc.MessageAnnotation
-	(*FieldAnnotation)(nil),               // 3: gen.svc.FieldAnnotation
-	(*EnumAnnotation)(nil),                // 4: gen.svc.EnumAnnotation
-	(*EnumValueAnnotation)(nil),           // 5: gen.svc.EnumValueAnnotation
-	(*OneofAnnotation)(nil),               // 6: gen.svc.OneofAnnotation
-	(*Delegate)(nil),                      // 7: gen.svc.Delegate
-	(*Receive)(nil),                       // 8: gen.svc.Receive
-	(*FieldReceive)(nil),                  // 9: gen.svc.FieldReceive
-	(*Validate)(nil),                      // 10: gen.svc.Validate
-	(*OneofValidate)(nil),                 // 11: gen.svc.OneofValidate
-	(*Number)(nil),                        // 12: gen.svc.Number
-	(*Converter)(nil),                     // 13: gen.svc.Converter
-	(*descriptorpb.FileOptions)(nil),      // 14: google.protobuf.FileOptions
-	(*descriptorpb.MethodOptions)(nil),    // 15: google.protobuf.MethodOptions
-	(*descriptorpb.MessageOptions)(nil),   // 16: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),     // 17: google.protobuf.FieldOptions
-	(*descriptorpb.EnumOptions)(nil),      // 18: google.protobuf.EnumOptions
-	(*descriptorpb.OneofOptions)(nil),     // 19: google.protobuf.OneofOptions
-	(*descriptorpb.EnumValueOptions)(nil), // 20: google.protobuf.EnumValueOptions
+	(Pagination_Style)(0),                 // 1: gen.svc.Pagination.Style
+	(Convert_Builtin)(0),                  // 2: gen.svc.Convert.Builtin
+	(*MethodAnnotation)(nil),              // 3: gen.svc.MethodAnnotation
+	(*MessageAnnotation)(nil),             // 4: gen.svc.MessageAnnotation
+	(*FieldAnnotation)(nil),               // 5: gen.svc.FieldAnnotation
+	(*EnumAnnotation)(nil),                // 6: gen.svc.EnumAnnotation
+	(*EnumValueAnnotation)(nil),           // 7: gen.svc.EnumValueAnnotation
+	(*OneofAnnotation)(nil),               // 8: gen.svc.OneofAnnotation
+	(*Delegate)(nil),                      // 9: gen.svc.Delegate
+	(*Receive)(nil),                       // 10: gen.svc.Receive
+	(*FieldReceive)(nil),                  // 11: gen.svc.FieldReceive
+	(*Validate)(nil),                      // 12: gen.svc.Validate
+	(*OneofValidate)(nil),                 // 13: gen.svc.OneofValidate
+	(*Number)(nil),                        // 14: gen.svc.Number
+	(*Pagination)(nil),                    // 15: gen.svc.Pagination
+	(*Alias)(nil),                         // 16: gen.svc.Alias
+	(*Convert)(nil),                       // 17: gen.svc.Convert
+	(*Compose)(nil),                       // 18: gen.svc.Compose
+	(*Converter)(nil),                     // 19: gen.svc.Converter
+	(*descriptorpb.FileOptions)(nil),      // 20: google.protobuf.FileOptions
+	(*descriptorpb.MethodOptions)(nil),    // 21: google.protobuf.MethodOptions
+	(*descriptorpb.MessageOptions)(nil),   // 22: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),     // 23: google.protobuf.FieldOptions
+	(*descriptorpb.EnumOptions)(nil),      // 24: google.protobuf.EnumOptions
+	(*descriptorpb.OneofOptions)(nil),     // 25: google.protobuf.OneofOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 26: google.protobuf.EnumValueOptions
 }
 var file_annotations_proto_depIdxs = []int32{
-	7,  // 0: gen.svc.MethodAnnotation.delegate:type_name -> gen.svc.Delegate
-	13, // 1: gen.svc.MethodAnnotation.converter:type_name -> gen.svc.Converter
-	7,  // 2: gen.svc.MessageAnnotation.delegate:type_name -> gen.svc.Delegate
-	13, // 3: gen.svc.MessageAnnotation.converter:type_name -> gen.svc.Converter
-	7,  // 4: gen.svc.FieldAnnotation.delegate:type_name -> gen.svc.Delegate
-	9,  // 5: gen.svc.FieldAnnotation.receive:type_name -> gen.svc.FieldReceive
-	10, // 6: gen.svc.FieldAnnotation.validate:type_name -> gen.svc.Validate
-	7,  // 7: gen.svc.EnumAnnotation.delegate:type_name -> gen.svc.Delegate
-	7,  // 8: gen.svc.EnumValueAnnotation.delegate:type_name -> gen.svc.Delegate
-	8,  // 9: gen.svc.EnumValueAnnotation.receive:type_name -> gen.svc.Receive
-	7,  // 10: gen.svc.OneofAnnotation.delegate:type_name -> gen.svc.Delegate
-	9,  // 11: gen.svc.OneofAnnotation.receive:type_name -> gen.svc.FieldReceive
-	11, // 12: gen.svc.OneofAnnotation.validate:type_name -> gen.svc.OneofValidate
-	12, // 13: gen.svc.Validate.min:type_name -> gen.svc.Number
-	12, // 14: gen.svc.Validate.max:type_name -> gen.svc.Number
-	0,  // 15: gen.svc.Validate.is:type_name -> gen.svc.Validate.IsType
-	14, // 16: gen.svc.go_package:extendee -> google.protobuf.FileOptions
-	15, // 17: gen.svc.method:extendee -> google.protobuf.MethodOptions
-	16, // 18: gen.svc.message:extendee -> google.protobuf.MessageOptions
-	17, // 19: gen.svc.field:extendee -> google.protobuf.FieldOptions
-	18, // 20: gen.svc.enum:extendee -> google.protobuf.EnumOptions
-	19, // 21: gen.svc.oneof:extendee -> google.protobuf.OneofOptions
-	20, // 22: gen.svc.enum_value:extendee -> google.protobuf.EnumValueOptions
-	1,  // 23: gen.svc.method:type_name -> gen.svc.MethodAnnotation
-	2,  // 24: gen.svc.message:type_name -> gen.svc.MessageAnnotation
-	3,  // 25: gen.svc.field:type_name -> gen.svc.FieldAnnotation
-	4,  // 26: gen.svc.enum:type_name -> gen.svc.EnumAnnotation
-	6,  // 27: gen.svc.oneof:type_name -> gen.svc.OneofAnnotation
-	5,  // 28: gen.svc.enum_value:type_name -> gen.svc.EnumValueAnnotation
-	29, // [29:29] is the sub-list for method output_type
-	29, // [29:29] is the sub-list for method input_type
-	23, // [23:29] is the sub-list for extension type_name
-	16, // [16:23] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	9,  // 0: gen.svc.MethodAnnotation.delegate:type_name -> gen.svc.Delegate
+	19, // 1: gen.svc.MethodAnnotation.converter:type_name -> gen.svc.Converter
+	15, // 2: gen.svc.MethodAnnotation.pagination:type_name -> gen.svc.Pagination
+	16, // 3: gen.svc.MethodAnnotation.alias:type_name -> gen.svc.Alias
+	9,  // 4: gen.svc.MessageAnnotation.delegate:type_name -> gen.svc.Delegate
+	19, // 5: gen.svc.MessageAnnotation.converter:type_name -> gen.svc.Converter
+	18, // 6: gen.svc.MessageAnnotation.compose:type_name -> gen.svc.Compose
+	9,  // 7: gen.svc.FieldAnnotation.delegate:type_name -> gen.svc.Delegate
+	11, // 8: gen.svc.FieldAnnotation.receive:type_name -> gen.svc.FieldReceive
+	12, // 9: gen.svc.FieldAnnotation.validate:type_name -> gen.svc.Validate
+	17, // 10: gen.svc.FieldAnnotation.convert:type_name -> gen.svc.Convert
+	9,  // 11: gen.svc.EnumAnnotation.delegate:type_name -> gen.svc.Delegate
+	9,  // 12: gen.svc.EnumValueAnnotation.delegate:type_name -> gen.svc.Delegate
+	10, // 13: gen.svc.EnumValueAnnotation.receive:type_name -> gen.svc.Receive
+	9,  // 14: gen.svc.OneofAnnotation.delegate:type_name -> gen.svc.Delegate
+	11, // 15: gen.svc.OneofAnnotation.receive:type_name -> gen.svc.FieldReceive
+	13, // 16: gen.svc.OneofAnnotation.validate:type_name -> gen.svc.OneofValidate
+	14, // 17: gen.svc.Validate.min:type_name -> gen.svc.Number
+	14, // 18: gen.svc.Validate.max:type_name -> gen.svc.Number
+	0,  // 19: gen.svc.Validate.is:type_name -> gen.svc.Validate.IsType
+	1,  // 20: gen.svc.Pagination.style:type_name -> gen.svc.Pagination.Style
+	2,  // 21: gen.svc.Convert.builtin:type_name -> gen.svc.Convert.Builtin
+	20, // 22: gen.svc.go_package:extendee -> google.protobuf.FileOptions
+	20, // 23: gen.svc.private_package:extendee -> google.protobuf.FileOptions
+	20, // 24: gen.svc.next_package:extendee -> google.protobuf.FileOptions
+	21, // 25: gen.svc.method:extendee -> google.protobuf.MethodOptions
+	22, // 26: gen.svc.message:extendee -> google.protobuf.MessageOptions
+	23, // 27: gen.svc.field:extendee -> google.protobuf.FieldOptions
+	24, // 28: gen.svc.enum:extendee -> google.protobuf.EnumOptions
+	25, // 29: gen.svc.oneof:extendee -> google.protobuf.OneofOptions
+	26, // 30: gen.svc.enum_value:extendee -> google.protobuf.EnumValueOptions
+	3,  // 31: gen.svc.method:type_name -> gen.svc.MethodAnnotation
+	4,  // 32: gen.svc.message:type_name -> gen.svc.MessageAnnotation
+	5,  // 33: gen.svc.field:type_name -> gen.svc.FieldAnnotation
+	6,  // 34: gen.svc.enum:type_name -> gen.svc.EnumAnnotation
+	8,  // 35: gen.svc.oneof:type_name -> gen.svc.OneofAnnotation
+	7,  // 36: gen.svc.enum_value:type_name -> gen.svc.EnumValueAnnotation
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	31, // [31:37] is the sub-list for extension type_name
+	22, // [22:31] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_annotations_proto_init() }
@@ -1409,6 +1983,54 @@ func file_annotations_proto_init() {
 			}
 		}
 		file_annotations_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_annotations_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Alias); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_annotations_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Convert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_annotations_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Compose); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_annotations_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Converter); i {
 			case 0:
 				return &v.state
@@ -1431,9 +2053,9 @@ func file_annotations_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_annotations_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   13,
-			NumExtensions: 7,
+			NumEnums:      3,
+			NumMessages:   17,
+			NumExtensions: 9,
 			NumServices:   0,
 		},
 		GoTypes:           file_annotations_proto_goTypes,
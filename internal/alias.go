@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/dane/protoc-gen-go-svc/gen/svc"
+	"github.com/dane/protoc-gen-go-svc/internal/options"
+)
+
+type Alias struct {
+	Method      *Method
+	Input       *Field
+	Output      *Field
+	Concurrency uint32
+}
+
+// NewAlias creates an `Alias` of a method calling the `method` of the service
+// for each item of the input field. An error will be returned if the method or
+// fields cannot be found, or the fields do not hold the input and output of the
+// method.
+func NewAlias(m *Method, method *Method, alias *svc.Alias) (*Alias, error) {
+	a := &Alias{
+		Method:      method,
+		Concurrency: alias.GetConcurrency(),
+	}
+
+	var ok bool
+	a.Input, ok = m.Input.FieldByName[alias.GetInput()]
+	if !ok {
+		return nil, NewErrFieldNotFound(alias.GetInput(), m.Input)
+	}
+
+	a.Output, ok = m.Output.FieldByName[alias.GetOutput()]
+	if !ok {
+		return nil, NewErrFieldNotFound(alias.GetOutput(), m.Output)
+	}
+
+	if method.IsAlias || !isAliasField(a.Input, method.Input) || !isAliasField(a.Output, method.Output) {
+		return nil, NewErrInvalidAlias(m)
+	}
+
+	return a, nil
+}
+
+func isAliasField(f *Field, msg *Message) bool {
+	return f.IsMessage && f.IsRepeated && f.Message == msg
+}
+
+// markAliasMessages marks the input and output messages of alias methods. They
+// are not converted to the next service version or the private service.
+func markAliasMessages(svc *Service, service *protogen.Service) {
+	for _, method := range service.Methods {
+		if options.MethodAlias(method) == nil {
+			continue
+		}
+
+		svc.AliasMessageNames[messageKey(method.Input)] = true
+		svc.AliasMessageNames[messageKey(method.Output)] = true
+	}
+}
+
+// buildAliases assigns the methods called by alias methods. All methods of the
+// service must be created. Methods of the next service version cannot be
+// called.
+func buildAliases(svc *Service, service *protogen.Service) error {
+	for _, method := range service.Methods {
+		alias := options.MethodAlias(method)
+		if alias == nil {
+			continue
+		}
+
+		m := svc.MethodByName[methodKey(method)]
+		target, ok := svc.MethodByName[alias.GetMethod()]
+		if !ok {
+			if isNextMethod(svc, alias.GetMethod()) {
+				return NewErrUnsupportedNextAlias(m, alias.GetMethod(), svc.Next)
+			}
+
+			return NewErrMethodNotFound(alias.GetMethod(), svc)
+		}
+
+		a, err := NewAlias(m, target, alias)
+		if err != nil {
+			return err
+		}
+
+		m.Alias = a
+	}
+
+	return nil
+}
+
+// isNextMethod checks if the name, or full name, is of a method of the next
+// service version. Alias methods cannot call them.
+func isNextMethod(svc *Service, name string) bool {
+	if svc.Next == nil {
+		return false
+	}
+
+	for _, m := range svc.Next.Methods {
+		if m.Name == name || m.FullName == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Aliases returns the methods of the service implemented by calling another
+// method of the service.
+func (s *Service) Aliases() []*Method {
+	var aliases []*Method
+	for _, m := range s.Methods {
+		if m.IsAlias {
+			aliases = append(aliases, m)
+		}
+	}
+
+	return aliases
+}
+
+// ChainedMethods returns the methods of the service that call the next service
+// version or the private service.
+func (s *Service) ChainedMethods() []*Method {
+	var methods []*Method
+	for _, m := range s.Methods {
+		if !m.IsAlias {
+			methods = append(methods, m)
+		}
+	}
+
+	return methods
+}
+
+// ConvertedMessages returns the messages of the service that are converted to
+// the next service version or the private service.
+func (s *Service) ConvertedMessages() []*Message {
+	var messages []*Message
+	for _, msg := range s.Messages {
+		if !msg.IsAlias {
+			messages = append(messages, msg)
+		}
+	}
+
+	return messages
+}
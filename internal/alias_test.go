@@ -0,0 +1,132 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/dane/protoc-gen-go-svc/gen/svc"
+)
+
+// aliasMessage returns a message with a field of the repeated messages, or no
+// fields when the field name is empty.
+func aliasMessage(pkg, name, fieldName, typeName string) *descriptorpb.DescriptorProto {
+	msg := &descriptorpb.DescriptorProto{Name: proto.String(name)}
+	if fieldName != "" {
+		msg.Field = []*descriptorpb.FieldDescriptorProto{{
+			Name:     proto.String(fieldName),
+			JsonName: proto.String(fieldName),
+			Number:   proto.Int32(1),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String("." + pkg + "." + typeName),
+		}}
+	}
+
+	return msg
+}
+
+func aliasMethod(pkg, name, input, output string) *descriptorpb.MethodDescriptorProto {
+	return &descriptorpb.MethodDescriptorProto{
+		Name:       proto.String(name),
+		InputType:  proto.String("." + pkg + "." + input),
+		OutputType: proto.String("." + pkg + "." + output),
+	}
+}
+
+// aliasFile returns a file of a package with a `People.Get` method, when get is
+// true, and a `People.GetMany` method aliasing the method when it is not
+// empty.
+func aliasFile(pkg string, get bool, alias string) *descriptorpb.FileDescriptorProto {
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String(pkg + "/service.proto"),
+		Package: proto.String(pkg),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{
+			GoPackage: proto.String("example.com/" + pkg + ";" + pkg),
+		},
+		Dependency: []string{annotationsPath},
+		MessageType: []*descriptorpb.DescriptorProto{
+			aliasMessage(pkg, "GetRequest", "", ""),
+			aliasMessage(pkg, "GetResponse", "", ""),
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{Name: proto.String("People")}},
+	}
+
+	proto.SetExtension(file.Options, svc.E_GoPackage, "example.com/service;service")
+
+	service := file.Service[0]
+	if get {
+		service.Method = append(service.Method, aliasMethod(pkg, "Get", "GetRequest", "GetResponse"))
+	}
+
+	if alias != "" {
+		file.MessageType = append(file.MessageType,
+			aliasMessage(pkg, "GetManyRequest", "requests", "GetRequest"),
+			aliasMessage(pkg, "GetManyResponse", "responses", "GetResponse"),
+		)
+
+		method := aliasMethod(pkg, "GetMany", "GetManyRequest", "GetManyResponse")
+		method.Options = &descriptorpb.MethodOptions{}
+		proto.SetExtension(method.Options, svc.E_Method, &svc.MethodAnnotation{
+			Alias: &svc.Alias{Method: alias, Input: "requests", Output: "responses"},
+		})
+
+		service.Method = append(service.Method, method)
+	}
+
+	return file
+}
+
+func TestBuildAliases(t *testing.T) {
+	tests := map[string]struct {
+		V1  *descriptorpb.FileDescriptorProto
+		Err string
+	}{
+		"method of the same service": {
+			V1: aliasFile("v1", true, "Get"),
+		},
+		"method of the next service": {
+			V1:  aliasFile("v1", false, "Get"),
+			Err: "alias in method GetMany cannot call method Get of the next service of package v2, only methods of the same service",
+		},
+		"full name of a method of the next service": {
+			V1:  aliasFile("v1", false, "v2.People.Get"),
+			Err: "alias in method GetMany cannot call method v2.People.Get of the next service of package v2",
+		},
+		"unknown method": {
+			V1:  aliasFile("v1", true, "Fetch"),
+			Err: "failed to find method Fetch in service of package v1",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := &pluginpb.CodeGeneratorRequest{
+				FileToGenerate: []string{"private/service.proto", "v1/service.proto", "v2/service.proto"},
+				ProtoFile: []*descriptorpb.FileDescriptorProto{
+					protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto),
+					annotationsFile(),
+					aliasFile("private", true, ""),
+					test.V1,
+					aliasFile("v2", true, ""),
+				},
+			}
+
+			plugin, err := protogen.Options{}.New(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = (&Plugin{}).Run(plugin)
+			if got := errString(err); !strings.Contains(got, test.Err) || (test.Err == "") != (err == nil) {
+				t.Fatalf("got error %q, want %q", got, test.Err)
+			}
+		})
+	}
+}
@@ -0,0 +1,467 @@
+package internal
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// privatePackageSegment is the last segment of the name of a private package
+// that is discovered when the private package name is not set. A package named
+// `private`, the former default of the `private_package` parameter, is
+// preferred.
+const privatePackageSegment = "private"
+
+// versionPattern matches the last segment of a package name holding a
+// version, such as `v1`, `v1beta1`, or `v2alpha`.
+var versionPattern = regexp.MustCompile(`^v(\d+)(?:(alpha|beta)(\d*))?$`)
+
+// version is the version of a package. Stable versions have a stability of 2,
+// beta versions 1, and alpha versions 0.
+type version struct {
+	major     int
+	stability int
+	minor     int
+}
+
+// parseVersion parses the version of the last segment of a package name. The
+// second return value is false when the package is not versioned.
+func parseVersion(name protoreflect.FullName) (version, bool) {
+	match := versionPattern.FindStringSubmatch(string(name.Name()))
+	if match == nil {
+		return version{}, false
+	}
+
+	var v version
+	v.major, _ = strconv.Atoi(match[1])
+	v.minor, _ = strconv.Atoi(match[3])
+
+	switch match[2] {
+	case "alpha":
+		v.stability = 0
+	case "beta":
+		v.stability = 1
+	default:
+		v.stability = 2
+	}
+
+	return v, true
+}
+
+// less checks if version `v` precedes version `o`.
+func (v version) less(o version) bool {
+	if v.major != o.major {
+		return v.major < o.major
+	}
+
+	if v.stability != o.stability {
+		return v.stability < o.stability
+	}
+
+	return v.minor < o.minor
+}
+
+// isPackageLess checks if package `a` is an older service version than package
+// `b`. Versioned packages are compared by version, such as `v1alpha1` < `v1beta1`
+// < `v1` < `v2` < `v10`. All other packages are compared lexically and precede
+// versioned packages.
+func isPackageLess(a, b protoreflect.FullName) bool {
+	va, okA := parseVersion(a)
+	vb, okB := parseVersion(b)
+
+	switch {
+	case okA && okB && va != vb:
+		return va.less(vb)
+	case okA != okB:
+		return okB
+	}
+
+	return a < b
+}
+
+// sortPackages sorts public packages from the latest service version to the
+// oldest. When `chain` is set, it lists every public package from the oldest
+// service version to the latest. An error will be returned if a package is
+// missing from `chain` or `chain` names a package that was not found.
+func sortPackages(packages []*Package, chain []string) ([]*Package, error) {
+	if len(chain) == 0 {
+		sorted := append([]*Package{}, packages...)
+		sort.SliceStable(sorted, func(a, b int) bool {
+			return isPackageLess(sorted[b].ProtoName, sorted[a].ProtoName)
+		})
+
+		return sorted, nil
+	}
+
+	byName := make(map[protoreflect.FullName]*Package)
+	for _, pkg := range packages {
+		byName[pkg.ProtoName] = pkg
+	}
+
+	var sorted []*Package
+	for _, name := range chain {
+		pkg, ok := byName[protoreflect.FullName(name)]
+		if !ok {
+			return nil, NewErrChainPackageNotFound(name)
+		}
+
+		delete(byName, pkg.ProtoName)
+		sorted = append([]*Package{pkg}, sorted...)
+	}
+
+	for _, pkg := range packages {
+		if _, ok := byName[pkg.ProtoName]; ok {
+			return nil, NewErrPackageNotInChain(pkg.ProtoName)
+		}
+	}
+
+	return sorted, nil
+}
+
+// parseList parses a semicolon separated plugin parameter, such as `chain`.
+// Plugin parameters are separated by commas, so their values cannot be.
+func parseList(list string) []string {
+	var names []string
+	for _, name := range strings.Split(list, ";") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// Chain is a chain of service versions generated to the same `go_package`
+// location, ending in a private service.
+type Chain struct {
+	PackageName string
+	ImportPath  string
+	Privates    []*Package
+	Packages    []*Package
+}
+
+// buildChains groups packages into chains by their `go_package` location.
+// Packages without a `go_package` location join the only chain, or may be
+// named as a private package of a chain. The public packages of each chain
+// are sorted from the latest service version to the oldest. An error will be
+// returned if the private package parameter names a missing package, a private
+// package cannot be found for a public package, or a package cannot be
+// assigned to a chain.
+func buildChains(packages map[protoreflect.FullName]*Package, privateName protoreflect.FullName, chain []string) ([]*Chain, error) {
+	// The default private package is only used by packages without a
+	// `private_package` option, but a parameter naming a missing package is
+	// always an error.
+	if _, ok := packages[privateName]; privateName != "" && !ok {
+		return nil, NewErrPrivatePackageNotFound(privateName)
+	}
+
+	byImportPath := make(map[protogen.GoImportPath]*Chain)
+	members := make(map[*Chain][]*Package)
+
+	var chains []*Chain
+	var unassigned []*Package
+	for _, pkg := range sortedPackages(packages) {
+		if pkg.ServiceImportPath == "" {
+			unassigned = append(unassigned, pkg)
+			continue
+		}
+
+		c, ok := byImportPath[pkg.ServiceImportPath]
+		if !ok {
+			c = &Chain{
+				PackageName: pkg.ServicePackageName,
+				ImportPath:  string(pkg.ServiceImportPath),
+			}
+
+			byImportPath[pkg.ServiceImportPath] = c
+			chains = append(chains, c)
+		}
+
+		members[c] = append(members[c], pkg)
+	}
+
+	// Packages without a `go_package` location join the only chain. This is
+	// also the case when no package sets a location.
+	if len(chains) == 0 {
+		chains = append(chains, &Chain{})
+	}
+
+	if len(chains) == 1 {
+		members[chains[0]] = append(members[chains[0]], unassigned...)
+		unassigned = nil
+	}
+
+	private := make(map[*Package]bool)
+	for _, c := range chains {
+		if err := assignPrivatePackages(packages, members[c], privateName, private); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, pkg := range unassigned {
+		if !private[pkg] {
+			return nil, NewErrChainNotFound(pkg.ProtoName)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var result []*Chain
+	for _, c := range chains {
+		var public []*Package
+		var order []string
+		for _, pkg := range members[c] {
+			if private[pkg] {
+				continue
+			}
+
+			public = append(public, pkg)
+		}
+
+		// Chains only made up of a private package called by another chain
+		// are not generated.
+		if len(public) == 0 {
+			continue
+		}
+
+		// Private packages are generated in order of name, once for each
+		// chain that calls them.
+		privates := make(map[*Package]bool)
+		for _, pkg := range public {
+			privates[pkg.Private] = true
+		}
+
+		for _, pkg := range sortedPackages(packages) {
+			if privates[pkg] {
+				c.Privates = append(c.Privates, pkg)
+			}
+		}
+
+		for _, name := range chain {
+			for _, pkg := range public {
+				if string(pkg.ProtoName) == name {
+					order = append(order, name)
+					seen[name] = true
+				}
+			}
+		}
+
+		sorted, err := sortPackages(public, order)
+		if err != nil {
+			return nil, err
+		}
+
+		c.Packages, err = linkPackages(sorted)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, c)
+	}
+
+	for _, name := range chain {
+		if !seen[name] {
+			return nil, NewErrChainPackageNotFound(name)
+		}
+	}
+
+	// Services are generated to a directory named after the package of their
+	// `go_package` location, so chains cannot share a package name.
+	names := make(map[string]*Chain)
+	for _, c := range result {
+		if prev, ok := names[c.PackageName]; ok {
+			return nil, NewErrDuplicateChainPackageName(prev.ImportPath, c.ImportPath)
+		}
+
+		names[c.PackageName] = c
+	}
+
+	return result, nil
+}
+
+// linkPackages assigns the next package of each public package of a chain.
+// Packages without a `next_package` option call the following package of the
+// sorted chain with the same private package, forming a main line of versions
+// for each private package. A package whose `next_package` option names its
+// private package has no next package, so it calls the private service
+// directly, like the latest version, and does not join the main line. The
+// packages are returned in the order they must be created, with each package
+// following its next package. An error will be returned if a next package
+// cannot be found, has a different private package, or the next packages form
+// a cycle.
+func linkPackages(packages []*Package) ([]*Package, error) {
+	byName := make(map[protoreflect.FullName]*Package)
+	lines := make(map[*Package][]*Package)
+	for _, pkg := range packages {
+		byName[pkg.ProtoName] = pkg
+		pkg.Next = nil
+
+		if pkg.NextPackageName == "" {
+			line := lines[pkg.Private]
+			if len(line) > 0 {
+				pkg.Next = line[len(line)-1]
+			}
+
+			lines[pkg.Private] = append(line, pkg)
+		}
+	}
+
+	for _, pkg := range packages {
+		// Naming the private package calls the private service directly.
+		if pkg.NextPackageName == "" || pkg.NextPackageName == pkg.Private.ProtoName {
+			continue
+		}
+
+		next, ok := byName[pkg.NextPackageName]
+		if !ok {
+			return nil, NewErrNextPackageNotFound(pkg.ProtoName, pkg.NextPackageName)
+		}
+
+		if next.Private != pkg.Private {
+			return nil, NewErrNextPackagePrivateMismatch(pkg.ProtoName, next.ProtoName)
+		}
+
+		pkg.Next = next
+	}
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	var ordered []*Package
+	state := make(map[*Package]int)
+
+	var visit func(*Package) error
+	visit = func(pkg *Package) error {
+		switch state[pkg] {
+		case visiting:
+			return NewErrChainCycle(pkg.ProtoName)
+		case visited:
+			return nil
+		}
+
+		state[pkg] = visiting
+		if pkg.Next != nil {
+			if err := visit(pkg.Next); err != nil {
+				return err
+			}
+		}
+
+		state[pkg] = visited
+		ordered = append(ordered, pkg)
+		return nil
+	}
+
+	for _, pkg := range packages {
+		if err := visit(pkg); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
+
+// sortedPackages returns the packages sorted by name.
+func sortedPackages(packages map[protoreflect.FullName]*Package) []*Package {
+	var sorted []*Package
+	for _, pkg := range packages {
+		sorted = append(sorted, pkg)
+	}
+
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].ProtoName < sorted[b].ProtoName
+	})
+
+	return sorted
+}
+
+// assignPrivatePackages assigns the private package of each package of a chain
+// and marks it in `private`. A package uses the private package named by its
+// `private_package` file option. Packages without the option use the default
+// private package of the chain. Packages that are a private package are left
+// unassigned.
+func assignPrivatePackages(packages map[protoreflect.FullName]*Package, members []*Package, name protoreflect.FullName, private map[*Package]bool) error {
+	for _, pkg := range members {
+		if pkg.PrivatePackageName == "" {
+			continue
+		}
+
+		priv, ok := packages[pkg.PrivatePackageName]
+		if !ok {
+			return NewErrPrivatePackageNotFound(pkg.PrivatePackageName)
+		}
+
+		pkg.Private = priv
+		private[priv] = true
+	}
+
+	// The default private package is only found when a package without the
+	// option requires it.
+	var fallback *Package
+	for _, pkg := range members {
+		if pkg.Private != nil || private[pkg] {
+			continue
+		}
+
+		if fallback == nil {
+			var err error
+			fallback, err = findPrivatePackage(packages, members, name)
+			if err != nil {
+				return err
+			}
+
+			private[fallback] = true
+		}
+
+		if pkg != fallback {
+			pkg.Private = fallback
+		}
+	}
+
+	return nil
+}
+
+// findPrivatePackage finds the default private package of a chain. It is named
+// by the `name` plugin parameter. When it is not set, the private package is
+// the package named `private`, as it was the default of the parameter, or the
+// only package of the chain with a last segment of `private`, such as
+// `example.private`.
+func findPrivatePackage(packages map[protoreflect.FullName]*Package, members []*Package, name protoreflect.FullName) (*Package, error) {
+	if name != "" {
+		pkg, ok := packages[name]
+		if !ok {
+			return nil, NewErrPrivatePackageNotFound(name)
+		}
+
+		return pkg, nil
+	}
+
+	var found *Package
+	for _, pkg := range members {
+		if pkg.ProtoName == privatePackageSegment {
+			return pkg, nil
+		}
+	}
+
+	for _, pkg := range members {
+		if pkg.ProtoName.Name() != privatePackageSegment {
+			continue
+		}
+
+		if found != nil {
+			return nil, NewErrPrivatePackageAmbiguous(found.ProtoName, pkg.ProtoName)
+		}
+
+		found = pkg
+	}
+
+	if found == nil {
+		return nil, NewErrPrivatePackageNotFound(privatePackageSegment)
+	}
+
+	return found, nil
+}
@@ -0,0 +1,538 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// packageNames returns the names of the packages joined by spaces.
+func packageNames(packages []*Package) string {
+	var names []string
+	for _, pkg := range packages {
+		names = append(names, string(pkg.ProtoName))
+	}
+
+	return strings.Join(names, " ")
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	return err.Error()
+}
+
+func TestParseVersion(t *testing.T) {
+	tests := map[protoreflect.FullName]struct {
+		Version   version
+		Versioned bool
+	}{
+		"example.v1":       {Version: version{major: 1, stability: 2}, Versioned: true},
+		"example.v10":      {Version: version{major: 10, stability: 2}, Versioned: true},
+		"example.v1beta1":  {Version: version{major: 1, stability: 1, minor: 1}, Versioned: true},
+		"example.v2alpha":  {Version: version{major: 2, stability: 0}, Versioned: true},
+		"example.v1alpha3": {Version: version{major: 1, stability: 0, minor: 3}, Versioned: true},
+		"v3":               {Version: version{major: 3, stability: 2}, Versioned: true},
+		"example.private":  {},
+		"example.v1.api":   {},
+		"example.version1": {},
+		"example.v1gamma":  {},
+	}
+
+	for name, test := range tests {
+		t.Run(string(name), func(t *testing.T) {
+			v, ok := parseVersion(name)
+			if ok != test.Versioned || v != test.Version {
+				t.Fatalf("got %+v, %t, want %+v, %t", v, ok, test.Version, test.Versioned)
+			}
+		})
+	}
+}
+
+func TestIsPackageLess(t *testing.T) {
+	// Packages in order from the oldest service version to the latest.
+	ordered := []protoreflect.FullName{
+		"example.legacy",
+		"example.stable",
+		"example.v1alpha1",
+		"example.v1alpha2",
+		"example.v1beta1",
+		"example.v1",
+		"example.v2",
+		"example.v10",
+	}
+
+	for i, a := range ordered {
+		for j, b := range ordered {
+			if got, want := isPackageLess(a, b), i < j; got != want {
+				t.Errorf("isPackageLess(%s, %s) = %t, want %t", a, b, got, want)
+			}
+		}
+	}
+
+	// Packages with the same version are compared lexically.
+	if !isPackageLess("a.v1", "b.v1") || isPackageLess("b.v1", "a.v1") {
+		t.Error("packages of the same version are not compared lexically")
+	}
+}
+
+func TestSortPackages(t *testing.T) {
+	tests := map[string]struct {
+		Packages []string
+		Chain    []string
+		Want     string
+		Err      string
+	}{
+		"version order": {
+			Packages: []string{"example.v10", "example.v1", "example.v1beta1", "example.v2", "example.v1alpha1"},
+			Want:     "example.v10 example.v2 example.v1 example.v1beta1 example.v1alpha1",
+		},
+		"non-versioned packages precede versions": {
+			Packages: []string{"example.v1", "example.stable", "example.legacy"},
+			Want:     "example.v1 example.stable example.legacy",
+		},
+		"chain overrides version order": {
+			Packages: []string{"example.v1", "example.v2", "example.stable"},
+			Chain:    []string{"example.v2", "example.stable", "example.v1"},
+			Want:     "example.v1 example.stable example.v2",
+		},
+		"chain names a missing package": {
+			Packages: []string{"example.v1", "example.v2"},
+			Chain:    []string{"example.v1", "example.v2", "example.v3"},
+			Err:      "package example.v3 of chain was not found",
+		},
+		"package omitted from chain": {
+			Packages: []string{"example.v1", "example.v2", "example.v3"},
+			Chain:    []string{"example.v1", "example.v3"},
+			Err:      "package example.v2 is missing from chain",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var packages []*Package
+			for _, name := range test.Packages {
+				packages = append(packages, &Package{ProtoName: protoreflect.FullName(name)})
+			}
+
+			sorted, err := sortPackages(packages, test.Chain)
+			if got := errString(err); got != test.Err {
+				t.Fatalf("got error %q, want %q", got, test.Err)
+			}
+
+			if got := packageNames(sorted); got != test.Want {
+				t.Fatalf("got %q, want %q", got, test.Want)
+			}
+		})
+	}
+}
+
+func TestParseList(t *testing.T) {
+	tests := map[string][]string{
+		"":                          nil,
+		"example.v1":                {"example.v1"},
+		"example.v1;example.v2":     {"example.v1", "example.v2"},
+		" example.v1 ; ;example.v2": {"example.v1", "example.v2"},
+	}
+
+	for chain, want := range tests {
+		if got := parseList(chain); strings.Join(got, " ") != strings.Join(want, " ") {
+			t.Errorf("parseList(%q) = %q, want %q", chain, got, want)
+		}
+	}
+}
+
+func TestFindPrivatePackage(t *testing.T) {
+	tests := map[string]struct {
+		Packages []string
+		Name     protoreflect.FullName
+		Want     protoreflect.FullName
+		Err      string
+	}{
+		"named by parameter": {
+			Packages: []string{"example.v1", "example.internal"},
+			Name:     "example.internal",
+			Want:     "example.internal",
+		},
+		"parameter names a missing package": {
+			Packages: []string{"example.v1", "example.private"},
+			Name:     "example.internal",
+			Err:      "private package example.internal was not found",
+		},
+		"last segment": {
+			Packages: []string{"example.v1", "example.private"},
+			Want:     "example.private",
+		},
+		"package named private is preferred": {
+			Packages: []string{"example.v1", "example.private", "private"},
+			Want:     "private",
+		},
+		"ambiguous last segment": {
+			Packages: []string{"billing.private", "example.private", "example.v1"},
+			Err:      "private package is ambiguous between billing.private and example.private, set private_package",
+		},
+		"not found": {
+			Packages: []string{"example.v1", "example.internal"},
+			Err:      "private package private was not found",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			packages := make(map[protoreflect.FullName]*Package)
+			for _, name := range test.Packages {
+				packages[protoreflect.FullName(name)] = &Package{ProtoName: protoreflect.FullName(name)}
+			}
+
+			pkg, err := findPrivatePackage(packages, sortedPackages(packages), test.Name)
+			if got := errString(err); got != test.Err {
+				t.Fatalf("got error %q, want %q", got, test.Err)
+			}
+
+			var got protoreflect.FullName
+			if pkg != nil {
+				got = pkg.ProtoName
+			}
+
+			if got != test.Want {
+				t.Fatalf("got %q, want %q", got, test.Want)
+			}
+		})
+	}
+}
+
+// testPackages returns the packages by name.
+func testPackages(packages ...*Package) map[protoreflect.FullName]*Package {
+	byName := make(map[protoreflect.FullName]*Package)
+	for _, pkg := range packages {
+		byName[pkg.ProtoName] = pkg
+	}
+
+	return byName
+}
+
+// testPackage returns a package with a `go_package` location of the import
+// path, whose package name is the last element of the path.
+func testPackage(name, importPath string) *Package {
+	pkg := &Package{
+		ProtoName:         protoreflect.FullName(name),
+		ServiceImportPath: protogen.GoImportPath(importPath),
+	}
+
+	if importPath != "" {
+		pkg.ServicePackageName = importPath[strings.LastIndex(importPath, "/")+1:]
+	}
+
+	return pkg
+}
+
+// chainString describes the chains as their package name, private packages
+// and public packages in order of creation.
+func chainString(chains []*Chain) string {
+	var parts []string
+	for _, c := range chains {
+		parts = append(parts, c.PackageName+"("+packageNames(c.Privates)+"): "+packageNames(c.Packages))
+	}
+
+	return strings.Join(parts, "; ")
+}
+
+func TestBuildChains(t *testing.T) {
+	tests := map[string]struct {
+		Packages    func() map[protoreflect.FullName]*Package
+		PrivateName protoreflect.FullName
+		Chain       []string
+		Want        string
+		Err         string
+	}{
+		"single chain without go_package": {
+			Packages: func() map[protoreflect.FullName]*Package {
+				return testPackages(
+					testPackage("example.v1", ""),
+					testPackage("example.v2", ""),
+					testPackage("example.private", ""),
+				)
+			},
+			Want: "(example.private): example.v2 example.v1",
+		},
+		"chains by go_package": {
+			Packages: func() map[protoreflect.FullName]*Package {
+				billing := testPackage("billing.v1", "example.com/billing/billingsvc")
+				billing.PrivatePackageName = "billing.private"
+
+				return testPackages(
+					testPackage("example.v1", "example.com/example/service"),
+					testPackage("example.v2", "example.com/example/service"),
+					testPackage("example.private", "example.com/example/service"),
+					billing,
+					testPackage("billing.private", ""),
+				)
+			},
+			Want: "billingsvc(billing.private): billing.v1; service(example.private): example.v2 example.v1",
+		},
+		"chain parameter orders each chain": {
+			Packages: func() map[protoreflect.FullName]*Package {
+				billing := testPackage("billing.v1", "example.com/billing/billingsvc")
+				billing.PrivatePackageName = "billing.private"
+				stable := testPackage("billing.stable", "example.com/billing/billingsvc")
+				stable.PrivatePackageName = "billing.private"
+
+				return testPackages(
+					testPackage("example.v1", "example.com/example/service"),
+					testPackage("example.private", "example.com/example/service"),
+					billing,
+					stable,
+					testPackage("billing.private", ""),
+				)
+			},
+			Chain: []string{"billing.v1", "billing.stable", "example.v1"},
+			Want:  "billingsvc(billing.private): billing.stable billing.v1; service(example.private): example.v1",
+		},
+		"chain parameter names a package of no chain": {
+			Packages: func() map[protoreflect.FullName]*Package {
+				return testPackages(
+					testPackage("example.v1", "example.com/example/service"),
+					testPackage("example.private", "example.com/example/service"),
+				)
+			},
+			Chain: []string{"example.v1", "example.v2"},
+			Err:   "package example.v2 of chain was not found",
+		},
+		"private package shared by chains": {
+			Packages: func() map[protoreflect.FullName]*Package {
+				return testPackages(
+					testPackage("example.v1", "example.com/example/service"),
+					testPackage("partner.v1", "example.com/partner/partnersvc"),
+					testPackage("example.private", ""),
+				)
+			},
+			PrivateName: "example.private",
+			Want:        "service(example.private): example.v1; partnersvc(example.private): partner.v1",
+		},
+		"private package of each version": {
+			Packages: func() map[protoreflect.FullName]*Package {
+				v1 := testPackage("example.v1", "")
+				v1.PrivatePackageName = "example.private.legacy"
+				v2 := testPackage("example.v2", "")
+				v2.PrivatePackageName = "example.private.legacy"
+				v3 := testPackage("example.v3", "")
+				v3.PrivatePackageName = "example.private.next"
+
+				return testPackages(
+					v1, v2, v3,
+					testPackage("example.private.legacy", ""),
+					testPackage("example.private.next", ""),
+				)
+			},
+			Want: "(example.private.legacy example.private.next): example.v3 example.v2 example.v1",
+		},
+		"private package option and default private package": {
+			Packages: func() map[protoreflect.FullName]*Package {
+				v3 := testPackage("example.v3", "")
+				v3.PrivatePackageName = "example.private.next"
+
+				return testPackages(
+					testPackage("example.v1", ""),
+					testPackage("example.v2", ""),
+					v3,
+					testPackage("example.private", ""),
+					testPackage("example.private.next", ""),
+				)
+			},
+			Want: "(example.private example.private.next): example.v3 example.v2 example.v1",
+		},
+		"private package option names a missing package": {
+			Packages: func() map[protoreflect.FullName]*Package {
+				v1 := testPackage("example.v1", "")
+				v1.PrivatePackageName = "example.private.next"
+
+				return testPackages(v1, testPackage("example.private", ""))
+			},
+			Err: "private package example.private.next was not found",
+		},
+		"private package parameter names a missing package": {
+			Packages: func() map[protoreflect.FullName]*Package {
+				v1 := testPackage("example.v1", "")
+				v1.PrivatePackageName = "example.private"
+
+				return testPackages(v1, testPackage("example.private", ""))
+			},
+			PrivateName: "example.privat",
+			Err:         "private package example.privat was not found",
+		},
+		"duplicate chain package name": {
+			Packages: func() map[protoreflect.FullName]*Package {
+				return testPackages(
+					testPackage("billing.v1", "example.com/billing/service"),
+					testPackage("billing.private", "example.com/billing/service"),
+					testPackage("example.v1", "example.com/example/service"),
+					testPackage("example.private", "example.com/example/service"),
+				)
+			},
+			Err: "service import paths example.com/billing/service and example.com/example/service must have different package names",
+		},
+		"unassigned package": {
+			Packages: func() map[protoreflect.FullName]*Package {
+				return testPackages(
+					testPackage("billing.v1", "example.com/billing/billingsvc"),
+					testPackage("billing.private", "example.com/billing/billingsvc"),
+					testPackage("example.v1", "example.com/example/service"),
+					testPackage("example.private", "example.com/example/service"),
+					testPackage("example.v2", ""),
+				)
+			},
+			Err: "package example.v2 has no go_package and there is more than one chain",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			chains, err := buildChains(test.Packages(), test.PrivateName, test.Chain)
+			if got := errString(err); got != test.Err {
+				t.Fatalf("got error %q, want %q", got, test.Err)
+			}
+
+			if got := chainString(chains); got != test.Want {
+				t.Fatalf("got %q, want %q", got, test.Want)
+			}
+		})
+	}
+}
+
+// nextString describes the next package of each package in order of creation.
+func nextString(packages []*Package) string {
+	var parts []string
+	for _, pkg := range packages {
+		next := "-"
+		if pkg.Next != nil {
+			next = string(pkg.Next.ProtoName)
+		}
+
+		parts = append(parts, string(pkg.ProtoName)+">"+next)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func TestLinkPackages(t *testing.T) {
+	private := &Package{ProtoName: "example.private"}
+	legacy := &Package{ProtoName: "example.private.legacy"}
+
+	// newPackage returns a package of the private package with the
+	// `next_package` option.
+	newPackage := func(name string, priv *Package, next protoreflect.FullName) *Package {
+		return &Package{ProtoName: protoreflect.FullName(name), Private: priv, NextPackageName: next}
+	}
+
+	tests := map[string]struct {
+		// Packages are sorted from the latest service version to the oldest.
+		Packages func() []*Package
+		Want     string
+		Err      string
+	}{
+		"main line": {
+			Packages: func() []*Package {
+				return []*Package{
+					newPackage("example.v3", private, ""),
+					newPackage("example.v2", private, ""),
+					newPackage("example.v1", private, ""),
+				}
+			},
+			Want: "example.v3>- example.v2>example.v3 example.v1>example.v2",
+		},
+		"branch": {
+			Packages: func() []*Package {
+				return []*Package{
+					newPackage("example.v3", private, ""),
+					newPackage("example.v2partner", private, "example.v3"),
+					newPackage("example.v2", private, ""),
+					newPackage("example.v1", private, ""),
+				}
+			},
+			Want: "example.v3>- example.v2partner>example.v3 example.v2>example.v3 example.v1>example.v2",
+		},
+		"branch of branch": {
+			Packages: func() []*Package {
+				return []*Package{
+					newPackage("example.v3", private, ""),
+					newPackage("example.v2partner", private, "example.v3"),
+					newPackage("example.v2", private, ""),
+					newPackage("example.v1partner", private, "example.v2partner"),
+				}
+			},
+			Want: "example.v3>- example.v2partner>example.v3 example.v2>example.v3 example.v1partner>example.v2partner",
+		},
+		"next package is the private package": {
+			Packages: func() []*Package {
+				return []*Package{
+					newPackage("example.v2", private, ""),
+					newPackage("example.v1", private, "example.private"),
+				}
+			},
+			Want: "example.v2>- example.v1>-",
+		},
+		"main line of each private package": {
+			Packages: func() []*Package {
+				return []*Package{
+					newPackage("example.v3", private, ""),
+					newPackage("example.v2", legacy, ""),
+					newPackage("example.v1", legacy, ""),
+				}
+			},
+			Want: "example.v3>- example.v2>- example.v1>example.v2",
+		},
+		"next package not found": {
+			Packages: func() []*Package {
+				return []*Package{
+					newPackage("example.v2", private, ""),
+					newPackage("example.v1", private, "example.v3"),
+				}
+			},
+			Err: "next package example.v3 of package example.v1 was not found in its chain",
+		},
+		"private package mismatch": {
+			Packages: func() []*Package {
+				return []*Package{
+					newPackage("example.v2", private, ""),
+					newPackage("example.v1", legacy, "example.v2"),
+				}
+			},
+			Err: "next package example.v2 of package example.v1 must have the same private package",
+		},
+		"cycle": {
+			Packages: func() []*Package {
+				return []*Package{
+					newPackage("example.v3", private, ""),
+					newPackage("example.v2", private, "example.v1"),
+					newPackage("example.v1", private, "example.v2"),
+				}
+			},
+			Err: "package example.v2 is part of a cycle of next packages",
+		},
+		"self cycle": {
+			Packages: func() []*Package {
+				return []*Package{
+					newPackage("example.v1", private, "example.v1"),
+				}
+			},
+			Err: "package example.v1 is part of a cycle of next packages",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			packages, err := linkPackages(test.Packages())
+			if got := errString(err); got != test.Err {
+				t.Fatalf("got error %q, want %q", got, test.Err)
+			}
+
+			if got := nextString(packages); got != test.Want {
+				t.Fatalf("got %q, want %q", got, test.Want)
+			}
+		})
+	}
+}
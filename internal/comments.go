@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// commentText returns the text of proto comments without their leading space.
+func commentText(comments protogen.Comments) string {
+	lines := strings.Split(strings.TrimRight(string(comments), "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, " ")
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// comment formats paragraphs of text as a Go comment. Empty paragraphs are
+// skipped.
+func comment(paragraphs ...string) string {
+	var lines []string
+	for _, p := range paragraphs {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+
+		if len(lines) > 0 {
+			lines = append(lines, "//")
+		}
+
+		for _, line := range strings.Split(p, "\n") {
+			lines = append(lines, strings.TrimRight("// "+line, " "))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Note describes how the method is implemented.
+func (m *Method) Note() string {
+	switch {
+	case m.IsPrivate:
+		return ""
+	case m.IsAlias:
+		return fmt.Sprintf("%s calls %s for each item of %s.", m.FullName, m.Alias.Method.FullName, m.Alias.Input.FullName)
+	case m.IsHook:
+		return fmt.Sprintf("%s is implemented by the %sHook option.", m.FullName, m.Name)
+	case m.IsDeprecated:
+		return fmt.Sprintf("%s is deprecated; delegated to %s.", m.FullName, m.Private.FullName)
+	case m.IsLatest:
+		return fmt.Sprintf("%s is delegated to %s.", m.FullName, m.Private.FullName)
+	default:
+		return fmt.Sprintf("%s is delegated to %s.", m.FullName, m.Next.FullName)
+	}
+}
+
+// Note describes how a deprecated message is converted.
+func (m *Message) Note() string {
+	if !m.IsDeprecated || m.Private == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s is deprecated; converted to and from %s.", m.FullName, m.Private.FullName)
+}
+
+// Note describes how the field is converted.
+func (f *Field) Note() string {
+	switch {
+	case f.IsPrivate:
+		return ""
+	case f.IsDeprecated && f.Private != nil:
+		return fmt.Sprintf("%s is deprecated; converted to and from %s.", f.FullName, f.Private.FullName)
+	case f.IsLatest && f.Private != nil:
+		return fmt.Sprintf("%s is converted to and from %s.", f.FullName, f.Private.FullName)
+	case f.Next != nil:
+		return fmt.Sprintf("%s is converted to and from %s.", f.FullName, f.Next.FullName)
+	default:
+		return ""
+	}
+}
+
+// MutatorNote describes how a deprecated field of a method input is forwarded
+// to the private service.
+func (f *Field) MutatorNote() string {
+	return fmt.Sprintf("%s is deprecated; forwarded to %s via mutator.", f.FullName, f.Private.FullName)
+}
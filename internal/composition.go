@@ -0,0 +1,145 @@
+package internal
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/dane/protoc-gen-go-svc/gen/svc"
+	"github.com/dane/protoc-gen-go-svc/internal/options"
+)
+
+type Composition struct {
+	Fields    []*Field
+	Into      *Field
+	Separator string
+	Format    string
+	Func      string
+}
+
+// NewComposition creates a `Composition` of fields of a message into a field
+// of the message of the next service version. An error will be returned if a
+// field cannot be found or the fields cannot be composed.
+func NewComposition(msg *Message, compose *svc.Compose) (*Composition, error) {
+	if msg.IsLatest || msg.IsDeprecated || msg.IsAlias {
+		return nil, NewErrInvalidComposition(compose.GetInto(), msg)
+	}
+
+	c := &Composition{
+		Separator: compose.GetSeparator(),
+		Format:    compose.GetFormat(),
+		Func:      compose.GetFunc(),
+	}
+
+	var ok bool
+	c.Into, ok = msg.Next.FieldByName[compose.GetInto()]
+	if !ok {
+		return nil, NewErrFieldNotFound(compose.GetInto(), msg.Next)
+	}
+
+	for _, name := range compose.GetFields() {
+		f, ok := msg.FieldByName[name]
+		if !ok {
+			return nil, NewErrFieldNotFound(name, msg)
+		}
+
+		c.Fields = append(c.Fields, f)
+	}
+
+	if len(c.Fields) == 0 || !c.isValid() {
+		return nil, NewErrInvalidComposition(compose.GetInto(), msg)
+	}
+
+	return c, nil
+}
+
+// IsSplit checks if the `Into` field is split into the fields when converting
+// from the next service version. Deprecated fields are read from the private
+// service instead.
+func (c *Composition) IsSplit() bool {
+	for _, f := range c.Fields {
+		if !f.IsDeprecated {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Names returns the names of the fields as arguments of a function call or
+// assignment. The names of deprecated fields are replaced by `blank` when
+// given.
+func (c *Composition) Names(prefix, blank string) string {
+	names := make([]string, len(c.Fields))
+	for i, f := range c.Fields {
+		if f.IsDeprecated && blank != "" {
+			names[i] = blank
+		} else {
+			names[i] = prefix + f.Name
+		}
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func (c *Composition) isValid() bool {
+	fields := append([]*Field{c.Into}, c.Fields...)
+
+	switch {
+	case c.Func != "":
+		for _, f := range fields {
+			if !isFuncConvertible(f) {
+				return false
+			}
+		}
+	case c.Separator != "" || c.Format != "":
+		if c.Format != "" && c.IsSplit() {
+			return false
+		}
+
+		for _, f := range fields {
+			if !isStringType(f) || f.IsPointer() {
+				return false
+			}
+		}
+	default:
+		return false
+	}
+
+	return true
+}
+
+func markComposedFields(svc *Service, messages []*protogen.Message) {
+	for _, message := range messages {
+		if msg, ok := svc.MessageByName[messageKey(message)]; ok && !msg.IsPrivate {
+			for _, compose := range options.MessageCompose(message) {
+				for _, name := range compose.GetFields() {
+					msg.ComposedFieldNames[name] = true
+				}
+			}
+		}
+
+		markComposedFields(svc, message.Messages)
+	}
+}
+
+func buildCompositions(svc *Service, messages []*protogen.Message) error {
+	for _, message := range messages {
+		if msg, ok := svc.MessageByName[messageKey(message)]; ok && !msg.IsPrivate {
+			for _, compose := range options.MessageCompose(message) {
+				c, err := NewComposition(msg, compose)
+				if err != nil {
+					return NewErrCreateService(svc, err)
+				}
+
+				msg.Compositions = append(msg.Compositions, c)
+			}
+		}
+
+		if err := buildCompositions(svc, message.Messages); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
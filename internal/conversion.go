@@ -0,0 +1,176 @@
+package internal
+
+import (
+	"strings"
+
+	"github.com/dane/protoc-gen-go-svc/gen/svc"
+)
+
+const (
+	timestampFullName = "google.protobuf.Timestamp"
+	durationFullName  = "google.protobuf.Duration"
+)
+
+type Conversion struct {
+	Builtin string
+	Func    string
+}
+
+type FieldConverter struct {
+	Name string
+	In   string
+	Out  string
+}
+
+// NewConversion creates a `Conversion` between a field and the field it is
+// read from or written to. Nil is returned if the field types match or the
+// field has no conversion. An error will be returned if the conversion does
+// not support the field types.
+func NewConversion(f, target *Field, convert *svc.Convert) (*Conversion, error) {
+	if convert == nil || isMatch(f, target) {
+		return nil, nil
+	}
+
+	c := &Conversion{
+		Func: convert.GetFunc(),
+	}
+
+	if c.Func != "" {
+		if !isFuncConvertible(f) || !isFuncConvertible(target) {
+			return nil, NewErrInvalidConversion(f, target)
+		}
+
+		return c, nil
+	}
+
+	var ok bool
+	switch convert.GetBuiltin() {
+	case svc.Convert_INTEGER:
+		ok = isConvertible(f, target, isStringType, isIntegerType)
+	case svc.Convert_RFC3339:
+		ok = isConvertible(f, target, isStringType, isMessageOf(timestampFullName))
+	case svc.Convert_SECONDS:
+		ok = isConvertible(f, target, isIntegerType, isMessageOf(durationFullName))
+	}
+
+	if !ok {
+		return nil, NewErrInvalidConversion(f, target)
+	}
+
+	c.Builtin = convert.GetBuiltin().String()
+
+	return c, nil
+}
+
+// isConvertible checks if one field is of type `a` and the other field is of
+// type `b`. Conversions are supported in both directions. Fields with presence
+// require a user defined conversion.
+func isConvertible(f, target *Field, a, b func(*Field) bool) bool {
+	if f.IsPointer() || target.IsPointer() {
+		return false
+	}
+
+	return (a(f) && b(target)) || (b(f) && a(target))
+}
+
+// isFuncConvertible checks if a field can be passed to a user defined
+// conversion. Enums and messages of the service can't be referenced by the
+// `FieldConverters` of other service versions.
+func isFuncConvertible(f *Field) bool {
+	if f.IsRepeated || f.IsEnum || f.IsOneOf {
+		return false
+	}
+
+	return !f.IsMessage || f.Message.IsExternal
+}
+
+func isMessageOf(fullName string) func(*Field) bool {
+	return func(f *Field) bool {
+		return !f.IsRepeated && f.IsMessage && f.Message.FullName == fullName
+	}
+}
+
+// buildFieldConverters collects the user defined conversions and compositions
+// of the service. Conversions sharing a name must have the same signature.
+func buildFieldConverters(svc *Service) error {
+	byName := make(map[string]*FieldConverter)
+
+	add := func(name string, in, out []*Field) error {
+		fc := &FieldConverter{
+			Name: name,
+			In:   typesOf(in),
+			Out:  typesOf(out),
+		}
+
+		if prev, ok := byName[name]; ok {
+			if *prev != *fc {
+				return NewErrFieldConverterConflict(name)
+			}
+
+			return nil
+		}
+
+		byName[name] = fc
+		svc.FieldConverters = append(svc.FieldConverters, fc)
+		for _, f := range append(in, out...) {
+			svc.addConversionPackage(f)
+		}
+
+		return nil
+	}
+
+	for _, msg := range svc.Messages {
+		for _, f := range msg.Fields {
+			if c := f.ConvertNext; c != nil && c.Func != "" {
+				if err := add(c.Func+"ToNext", []*Field{f}, []*Field{f.Next}); err != nil {
+					return err
+				}
+
+				if err := add(c.Func+"FromNext", []*Field{f.Next}, []*Field{f}); err != nil {
+					return err
+				}
+			}
+
+			if c := f.ConvertPrivate; c != nil && c.Func != "" {
+				if err := add(c.Func+"ToPrivate", []*Field{f}, []*Field{f.Private}); err != nil {
+					return err
+				}
+
+				if err := add(c.Func+"FromPrivate", []*Field{f.Private}, []*Field{f}); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, c := range msg.Compositions {
+			if c.Func == "" {
+				continue
+			}
+
+			if err := add(c.Func+"Compose", c.Fields, []*Field{c.Into}); err != nil {
+				return err
+			}
+
+			if !c.IsSplit() {
+				continue
+			}
+
+			if err := add(c.Func+"Split", []*Field{c.Into}, c.Fields); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// typesOf returns the types of the fields as a list of function parameters or
+// results.
+func typesOf(fields []*Field) string {
+	types := make([]string, len(fields))
+	for i, f := range fields {
+		types[i] = typeOf(f)
+	}
+
+	return strings.Join(types, ", ")
+}
@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"regexp"
+)
+
+var nodeIDPattern = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// nodeID returns an identifier of a diagram node usable by Mermaid and DOT.
+func nodeID(name string) string {
+	return nodeIDPattern.ReplaceAllString(name, "_")
+}
+
+// MigrationEdge is a method call of the migration map.
+type MigrationEdge struct {
+	From string
+	To   string
+
+	// Label describes calls that are not to the same method of the next
+	// service version.
+	Label string
+
+	// IsBypass is true when a deprecated method skips the following service
+	// versions to call the private service directly.
+	IsBypass bool
+}
+
+// Label returns the label of a method node of the migration map.
+func (m *Method) Label() string {
+	switch {
+	case m.IsAlias:
+		return m.Name + " (alias)"
+	case m.IsHook:
+		return m.Name + " (hook)"
+	case m.IsDeprecated:
+		return m.Name + " (deprecated)"
+	default:
+		return m.Name
+	}
+}
+
+// Edges returns the method calls of the public services of the chain. Hooked
+// methods are not included since the hook decides which methods are called.
+func (r RegisterService) Edges() []MigrationEdge {
+	var edges []MigrationEdge
+	for _, svc := range r.Services {
+		for _, m := range svc.Methods {
+			edge := MigrationEdge{From: m.FullName}
+
+			switch {
+			case m.IsAlias:
+				edge.To = m.Alias.Method.FullName
+				edge.Label = "alias"
+			case m.IsHook:
+				continue
+			case m.Next != nil:
+				edge.To = m.Next.FullName
+				if m.Next.Name != m.Name {
+					edge.Label = "delegate"
+				}
+			case m.Private != nil:
+				edge.To = m.Private.FullName
+				if m.IsDeprecated {
+					edge.Label = "deprecated"
+					edge.IsBypass = true
+				} else if m.Private.Name != m.Name {
+					edge.Label = "delegate"
+				}
+			default:
+				continue
+			}
+
+			edges = append(edges, edge)
+		}
+	}
+
+	return edges
+}
+
+// Label returns the label of a field in the migration map.
+func (f *Field) Label() string {
+	switch {
+	case f.IsDeprecated:
+		return f.ProtoName + " (deprecated)"
+	case f.IsComposed:
+		return f.ProtoName + " (composed)"
+	case f.IsMerged:
+		return f.ProtoName + " (merged)"
+	default:
+		return f.ProtoName
+	}
+}
@@ -13,9 +13,17 @@ type EnumValue struct {
 	IsDeprecated bool
 	IsPrivate    bool
 	Name         string
+	FullName     string
 	Next         *EnumValue
 	Private      *EnumValue
 	Receive      []*EnumValue
+
+	// ReceiveName is the ignored `name` of the receive annotation.
+	ReceiveName string
+
+	// UnusedReceiveNames are the receive names of a private enum value.
+	// Private enum values are not converted, so they are unused.
+	UnusedReceiveNames []string
 }
 
 func (v *EnumValue) Type() string {
@@ -50,10 +58,13 @@ func NewEnumValue(f *Field, value *protogen.EnumValue) (*EnumValue, error) {
 		IsPrivate:    f.IsPrivate,
 		IsDeprecated: f.IsDeprecated,
 		Name:         value.GoIdent.GoName,
+		FullName:     string(value.Desc.FullName()),
+		ReceiveName:  options.ReceiveEnumValueName(value),
 	}
 
 	// Private enum values are the last in the service chain.
 	if v.IsPrivate {
+		v.UnusedReceiveNames = options.ReceiveEnumValueNamesSet(value)
 		return v, nil
 	}
 
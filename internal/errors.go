@@ -2,6 +2,7 @@ package internal
 
 import (
 	"fmt"
+	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
@@ -11,6 +12,54 @@ func NewErrPrivatePackageNotFound(name protoreflect.FullName) error {
 	return fmt.Errorf("private package %s was not found", name)
 }
 
+func NewErrPrivatePackageAmbiguous(a, b protoreflect.FullName) error {
+	return fmt.Errorf("private package is ambiguous between %s and %s, set private_package", a, b)
+}
+
+func NewErrChainNotFound(name protoreflect.FullName) error {
+	return fmt.Errorf("package %s has no go_package and there is more than one chain", name)
+}
+
+func NewErrReadTemplates(dir string, err error) error {
+	return fmt.Errorf("failed to read templates of directory %s: %w", dir, err)
+}
+
+func NewErrParseTemplate(name string, err error) error {
+	return fmt.Errorf("failed to parse template file %s: %w", name, err)
+}
+
+func NewErrBadNextPackage(file *protogen.File, name, fileName string) error {
+	return fmt.Errorf("file %s has next package of %q, but expected %q", file.Desc.Path(), fileName, name)
+}
+
+func NewErrNextPackageNotFound(pkgName, name protoreflect.FullName) error {
+	return fmt.Errorf("next package %s of package %s was not found in its chain", name, pkgName)
+}
+
+func NewErrNextPackagePrivateMismatch(pkgName, name protoreflect.FullName) error {
+	return fmt.Errorf("next package %s of package %s must have the same private package", name, pkgName)
+}
+
+func NewErrChainCycle(name protoreflect.FullName) error {
+	return fmt.Errorf("package %s is part of a cycle of next packages", name)
+}
+
+func NewErrDuplicateChainPackageName(a, b string) error {
+	return fmt.Errorf("service import paths %s and %s must have different package names", a, b)
+}
+
+func NewErrBadPrivatePackage(file *protogen.File, name, fileName string) error {
+	return fmt.Errorf("file %s has private package of %q, but expected %q", file.Desc.Path(), fileName, name)
+}
+
+func NewErrChainPackageNotFound(name string) error {
+	return fmt.Errorf("package %s of chain was not found", name)
+}
+
+func NewErrPackageNotInChain(name protoreflect.FullName) error {
+	return fmt.Errorf("package %s is missing from chain", name)
+}
+
 func NewErrCreateService(svc *Service, err error) error {
 	return fmt.Errorf("failed to create service %s of package %s: %w", svc.Name, svc.ProtoPackageName, err)
 }
@@ -47,6 +96,86 @@ func NewErrInvalidRuleIn(f *Field, value string) error {
 	return fmt.Errorf("invalid value %q in `in` annotation in field %s", value, f.Name)
 }
 
+func NewErrInvalidPaginationStyle(m *Method) error {
+	return fmt.Errorf("invalid pagination style in method %s", m.Name)
+}
+
+func NewErrInvalidPaginationField(f *Field, msg *Message) error {
+	return fmt.Errorf("invalid pagination field %s in message %s", f.Name, msg.Name)
+}
+
+func NewErrPaginationMismatch(a, b *Method) error {
+	return fmt.Errorf("method %s and method %s must both be paginated", a.Name, b.Name)
+}
+
+func NewErrPaginationResultsRequired(m *Method) error {
+	return fmt.Errorf("pagination results field is required in method %s", m.Name)
+}
+
+func NewErrInvalidHook(m *Method) error {
+	return fmt.Errorf("invalid hook in method %s", m.Name)
+}
+
+func NewErrInvalidAlias(m *Method) error {
+	return fmt.Errorf("invalid alias in method %s", m.Name)
+}
+
+func NewErrUnsupportedNextAlias(m *Method, methodName string, next *Service) error {
+	return fmt.Errorf("alias in method %s cannot call method %s of the next service of package %s, only methods of the same service", m.Name, methodName, next.ProtoPackageName)
+}
+
+func NewErrInvalidAliasMessage(msg *Message) error {
+	return fmt.Errorf("message %s of an alias method cannot be converted", msg.Name)
+}
+
+func NewErrInvalidConversion(f, target *Field) error {
+	return fmt.Errorf("invalid conversion between field %s of type %s and field %s of type %s", f.Name, f.Type, target.Name, target.Type)
+}
+
+func NewErrFieldConverterConflict(name string) error {
+	return fmt.Errorf("field converter %s is used by fields of different types", name)
+}
+
+func NewErrInvalidComposition(fieldName string, msg *Message) error {
+	return fmt.Errorf("invalid composition into field %s of message %s", fieldName, msg.Name)
+}
+
+func NewErrInvalidFieldPath(path string, msg *Message) error {
+	return fmt.Errorf("invalid field path %q from message %s", path, msg.Name)
+}
+
+func NewErrInvalidMergedField(f *Field, msg *Message) error {
+	return fmt.Errorf("invalid merge of field %s of message %s into private field %s", f.Name, msg.Name, f.Private.Name)
+}
+
 func NewErrInvalidRuleForField(f *Field, ruleName string) error {
 	return fmt.Errorf("invalid rule %q for field %s", ruleName, f.Name)
 }
+
+func NewErrLint(packageName string, issues []string) error {
+	return fmt.Errorf("lint of %s found %d issues:\n%s", packageName, len(issues), strings.Join(issues, "\n"))
+}
+
+func NewErrFieldNumberReserved(f *Field, msg *Message) error {
+	return fmt.Errorf("number %d of field %s is reserved by message %s", f.Number, f.FullName, msg.FullName)
+}
+
+func NewErrFieldNameReserved(f *Field, msg *Message) error {
+	return fmt.Errorf("name of field %s is reserved by message %s", f.FullName, msg.FullName)
+}
+
+func NewErrFieldNumberReused(f, other *Field) error {
+	return fmt.Errorf("number %d of field %s is reused by field %s", f.Number, f.FullName, other.FullName)
+}
+
+func NewErrReservedFieldNumberReused(msg *Message, f *Field) error {
+	return fmt.Errorf("number %d reserved by message %s is reused by field %s", f.Number, msg.FullName, f.FullName)
+}
+
+func NewErrReservedFieldNameReused(msg *Message, f *Field) error {
+	return fmt.Errorf("name %s reserved by message %s is reused by field %s", f.ProtoName, msg.FullName, f.FullName)
+}
+
+func NewErrInvalidFieldNumbers(value string) error {
+	return fmt.Errorf("invalid field_numbers %q, expected %q, %q or %q", value, FieldNumbersError, FieldNumbersWarn, FieldNumbersIgnore)
+}
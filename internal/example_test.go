@@ -0,0 +1,216 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/dane/protoc-gen-go-svc/gen/svc"
+
+	privatepb "github.com/dane/protoc-gen-go-svc/example/proto/go/private"
+	v1pb "github.com/dane/protoc-gen-go-svc/example/proto/go/v1"
+	v2pb "github.com/dane/protoc-gen-go-svc/example/proto/go/v2"
+)
+
+// exampleDir is the directory the example services are generated to.
+const exampleDir = "../example/proto/go"
+
+// annotationsPath is the path the example protos import the annotations from.
+// The annotations are compiled from their own directory, so they are
+// registered as `annotations.proto`.
+const annotationsPath = "gen/svc/annotations.proto"
+
+// annotationsFile returns the annotations at the path they are imported from.
+func annotationsFile() *descriptorpb.FileDescriptorProto {
+	file := protodesc.ToFileDescriptorProto(svc.File_annotations_proto)
+	file.Name = proto.String(annotationsPath)
+	return file
+}
+
+// exampleRequest returns a request generating the example protos, as compiled
+// by `make example`.
+func exampleRequest() *pluginpb.CodeGeneratorRequest {
+	req := &pluginpb.CodeGeneratorRequest{
+		Parameter: proto.String("paths=source_relative"),
+	}
+
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		if fd.Path() == annotationsPath {
+			fd = svc.File_annotations_proto
+		}
+
+		if seen[fd.Path()] {
+			return
+		}
+
+		seen[fd.Path()] = true
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+
+		if fd == svc.File_annotations_proto {
+			req.ProtoFile = append(req.ProtoFile, annotationsFile())
+			return
+		}
+
+		req.ProtoFile = append(req.ProtoFile, protodesc.ToFileDescriptorProto(fd))
+	}
+
+	for _, fd := range []protoreflect.FileDescriptor{
+		v1pb.File_v1_service_proto,
+		v2pb.File_v2_service_proto,
+		privatepb.File_private_service_proto,
+	} {
+		add(fd)
+		req.FileToGenerate = append(req.FileToGenerate, fd.Path())
+	}
+
+	return req
+}
+
+// generateExample runs the plugin over the example protos and returns the
+// content of the generated files by name.
+func generateExample(t *testing.T, p *Plugin) map[string]string {
+	t.Helper()
+
+	plugin, err := protogen.Options{}.New(exampleRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := p.Run(plugin); err != nil {
+		t.Fatal(err)
+	}
+
+	resp := plugin.Response()
+	if resp.Error != nil {
+		t.Fatal(resp.GetError())
+	}
+
+	files := make(map[string]string)
+	for _, file := range resp.File {
+		files[file.GetName()] = file.GetContent()
+	}
+
+	return files
+}
+
+// TestExampleDocs compares the migration map of the example with the files
+// written by `make example`.
+func TestExampleDocs(t *testing.T) {
+	files := generateExample(t, &Plugin{PrivatePackageName: "example.private", Docs: true})
+
+	for _, name := range []string{"service/" + MigrationMarkdownFileName, "service/" + MigrationDOTFileName} {
+		t.Run(name, func(t *testing.T) {
+			got, ok := files[name]
+			if !ok {
+				t.Fatalf("%s was not generated", name)
+			}
+
+			want, err := os.ReadFile(filepath.Join(exampleDir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != string(want) {
+				t.Fatalf("%s differs from %s, run `make example` to update it:\n%s", name, filepath.Join(exampleDir, name), got)
+			}
+		})
+	}
+}
+
+var update = flag.Bool("update", false, "update the golden files of the example")
+
+// TestExampleIR compares the intermediate representation of the example with
+// `testdata/ir.json`. Run the tests with `-update` to update the file after
+// adding keys. Removing a key, or changing its meaning, requires incrementing
+// `IRVersion`.
+func TestExampleIR(t *testing.T) {
+	const golden = "testdata/" + IRFileName
+
+	files := generateExample(t, &Plugin{PrivatePackageName: "example.private", IR: true})
+	got, ok := files["service/"+IRFileName]
+	if !ok {
+		t.Fatalf("%s was not generated", IRFileName)
+	}
+
+	if *update {
+		if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every key of the golden file must still be written, so it is decoded
+	// without unknown keys.
+	dec := json.NewDecoder(bytes.NewReader(want))
+	dec.DisallowUnknownFields()
+
+	var ir IR
+	if err := dec.Decode(&ir); err != nil {
+		t.Fatalf("%s has a key that is no longer written, increment IRVersion: %s", golden, err)
+	}
+
+	if ir.Version != IRVersion {
+		t.Fatalf("%s has version %d, want %d", golden, ir.Version, IRVersion)
+	}
+
+	if got != string(want) {
+		t.Fatalf("%s differs from the generated IR, run the tests with -update to update it:\n%s", golden, got)
+	}
+}
+
+// TestExampleComments checks that the leading comment of a message is written
+// once per file written by `make example`, on the converter to the private
+// service. The compiled example descriptors have no comments, so the files are
+// not generated by the test.
+func TestExampleComments(t *testing.T) {
+	const personComment = "Person is an entry of the directory."
+
+	tests := map[string]struct {
+		Converter string
+	}{
+		"service/v2/service.pb.go": {
+			Converter: "// ToPrivatePerson converts example.v2.Person to example.private.Person.\n//\n// " + personComment,
+		},
+		"service/v2/converters.js": {
+			Converter: "* toPrivatePerson converts example.v2.Person to example.private.Person.\n     *\n     * " + personComment,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			b, err := os.ReadFile(filepath.Join(exampleDir, name))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := string(b)
+			if n := strings.Count(got, personComment); n != 1 {
+				t.Fatalf("expected the Person comment once, got %d times", n)
+			}
+
+			if !strings.Contains(got, test.Converter) {
+				t.Fatalf("expected the Person comment on the converter %q", test.Converter)
+			}
+		})
+	}
+}
@@ -4,29 +4,53 @@ import (
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"github.com/dane/protoc-gen-go-svc/gen/svc"
 	"github.com/dane/protoc-gen-go-svc/internal/options"
 )
 
 type Field struct {
-	IsPrivate       bool
-	IsLatest        bool
-	IsDeprecated    bool
-	IsMessage       bool
-	IsEnum          bool
-	IsOneOf         bool
-	IsMatch         bool
-	IsRepeated      bool
-	IsRequired      bool
-	Name            string
-	EnumName        string
-	Type            Type
-	Private         *Field
-	Next            *Field
-	Message         *Message
-	Messages        []*Message
-	EnumValues      []*EnumValue
-	EnumValueByName map[string]*EnumValue
-	Rules           []string
+	IsPrivate           bool
+	IsLatest            bool
+	IsDeprecated        bool
+	IsMessage           bool
+	IsEnum              bool
+	IsOneOf             bool
+	IsMatch             bool
+	IsRepeated          bool
+	IsRequired          bool
+	IsPagination        bool
+	IsComposed          bool
+	IsMerged            bool
+	HasPresence         bool
+	IsWrapper           bool
+	IsPresenceConverted bool
+	Name                string
+	ProtoName           string
+	JSONName            string
+	FullName            string
+	Number              protoreflect.FieldNumber
+	Comments            string
+	EnumName            string
+	Type                Type
+	ValueType           Type
+	IsNextParentPath    bool
+	IsPrivateParentPath bool
+	Private             *Field
+	Next                *Field
+	NextPath            []*Field
+	PrivatePath         []*Field
+	ConvertNext         *Conversion
+	ConvertPrivate      *Conversion
+	Message             *Message
+	Messages            []*Message
+	Members             []*Field
+	MemberByName        map[string]*Field
+	EnumValues          []*EnumValue
+	EnumValueByName     map[string]*EnumValue
+	Rules               []string
+
+	// Validate is the validation annotation the rules are created from.
+	Validate *svc.Validate `json:"-"`
 }
 
 // NewField creates a `Field`. An error will be returned if the field cannot be
@@ -40,13 +64,23 @@ func NewField(svc *Service, msg *Message, field *protogen.Field) (*Field, error)
 		IsRepeated:      field.Desc.IsList(),
 		IsRequired:      options.IsRequiredField(field),
 		IsDeprecated:    options.IsDeprecatedField(field),
+		IsPagination:    msg.PaginationFieldNames[fieldKey(field)],
+		IsComposed:      msg.ComposedFieldNames[fieldKey(field)],
+		HasPresence:     field.Desc.HasPresence() && field.Message == nil,
 		Name:            field.GoName,
+		ProtoName:       string(field.Desc.Name()),
+		JSONName:        field.Desc.JSONName(),
+		FullName:        string(field.Desc.FullName()),
+		Number:          field.Desc.Number(),
+		Comments:        commentText(field.Comments.Leading),
 		EnumValueByName: make(map[string]*EnumValue),
 	}
 
 	switch field.Desc.Kind() {
 	case protoreflect.BoolKind:
 		f.Type = BooleanType
+	case protoreflect.Int32Kind:
+		f.Type = Int32Type
 	case protoreflect.Int64Kind:
 		f.Type = Int64Type
 	case protoreflect.Uint64Kind:
@@ -63,6 +97,15 @@ func NewField(svc *Service, msg *Message, field *protogen.Field) (*Field, error)
 		f.Type = EnumType
 	}
 
+	// Wrapper messages are converted to and from the scalar value they wrap.
+	f.ValueType = f.Type
+	if f.IsMessage {
+		f.ValueType, f.IsWrapper = wrapperTypes[field.Message.Desc.FullName()]
+		if !f.IsWrapper {
+			f.ValueType = f.Type
+		}
+	}
+
 	// Assign the message that is populating the field. Messages are assigned
 	// before the field is fully populated to allow the `isMatch` check to run.
 	if f.IsMessage {
@@ -73,34 +116,76 @@ func NewField(svc *Service, msg *Message, field *protogen.Field) (*Field, error)
 		if !ok {
 			return nil, NewErrMessageNotFound(messageKey(field.Message), svc)
 		}
+
+		if f.Message.IsAlias && !msg.IsAlias {
+			return nil, NewErrCreateField(f, msg, NewErrInvalidAliasMessage(f.Message))
+		}
 	}
 
 	// Assign the private field and next field. This is only done if the field
 	// isn't private since the private service, message, fields, etc. are the
-	// first in the chain.
-	if !f.IsPrivate {
+	// first in the chain. Pagination fields are converted by the method, not
+	// the message, since they differ between pagination styles. Fields of
+	// alias messages are not converted.
+	if !f.IsPrivate && !f.IsPagination && !msg.IsAlias {
 		fieldName := options.FieldName(field)
 		var ok bool
 
-		// Latest and deprecated fields chain directly to the private service.
-		// They don't have a "next" service.
-		if !f.IsLatest && !f.IsDeprecated && !msg.IsDeprecated {
-			f.Next, ok = msg.Next.FieldByName[fieldName]
-			if !ok {
-				return nil, NewErrFieldNotFound(fieldName, msg.Next)
+		if isFieldPath(fieldName) {
+			// Fields delegated to a path are written to and read from a field
+			// of a nested or parent message.
+			if err := assignFieldPaths(f, msg, fieldName); err != nil {
+				return nil, NewErrCreateField(f, msg, err)
+			}
+		} else {
+			// Latest and deprecated fields chain directly to the private
+			// service. They don't have a "next" service. Composed fields are
+			// written to and read from a different field of the next service.
+			if !f.IsLatest && !f.IsDeprecated && !msg.IsDeprecated && !f.IsComposed {
+				f.Next, ok = msg.Next.FieldByName[fieldName]
+				if !ok {
+					return nil, NewErrFieldNotFound(fieldName, msg.Next)
+				}
 			}
-		}
 
-		f.Private, ok = msg.Private.FieldByName[fieldName]
-		if !ok {
-			return nil, NewErrFieldNotFound(fieldName, msg.Private)
+			// A next field moved within the private service is followed to
+			// the private field it is moved to.
+			if f.Next != nil && f.Next.IsPrivateMoved() {
+				f.Private = f.Next.Private
+				f.PrivatePath = f.Next.PrivatePath
+				f.IsPrivateParentPath = f.Next.IsPrivateParentPath
+			} else {
+				f.Private, ok = msg.Private.FieldByName[fieldName]
+				if !ok {
+					return nil, NewErrFieldNotFound(fieldName, msg.Private)
+				}
+			}
 		}
 
-		if f.IsLatest || f.IsDeprecated || msg.IsDeprecated {
+		if f.Next == nil {
 			f.IsMatch = isMatch(f, f.Private)
+			f.IsPresenceConverted = isPresenceConverted(f, f.Private)
 		} else {
 			f.IsMatch = isMatch(f, f.Next)
+			f.IsPresenceConverted = isPresenceConverted(f, f.Next)
+		}
+
+		convert := options.FieldConvert(field)
+		if f.Next != nil {
+			conversion, err := NewConversion(f, f.Next, convert)
+			if err != nil {
+				return nil, NewErrCreateField(f, msg, err)
+			}
+
+			f.ConvertNext = conversion
+		}
+
+		conversion, err := NewConversion(f, f.Private, convert)
+		if err != nil {
+			return nil, NewErrCreateField(f, msg, err)
 		}
+
+		f.ConvertPrivate = conversion
 	}
 
 	// Enums are created after the private and next fields are assigned. This
@@ -118,7 +203,8 @@ func NewField(svc *Service, msg *Message, field *protogen.Field) (*Field, error)
 		}
 	}
 
-	rules, err := NewRules(f, options.FieldValidate(field))
+	f.Validate = options.FieldValidate(field)
+	rules, err := NewRules(f, f.Validate)
 	if err != nil {
 		return nil, NewErrCreateField(f, msg, err)
 	}
@@ -145,12 +231,44 @@ func isExternalMessage(svc *Service, message *protogen.Message) bool {
 	return svc.ImportPath != string(message.GoIdent.GoImportPath)
 }
 
+// IsPointer returns true when the field is a pointer to a scalar value. Scalar
+// fields with presence, such as proto3 `optional` fields, are pointers. Bytes
+// track presence with a nil slice instead.
+func (f *Field) IsPointer() bool {
+	return f.HasPresence && f.Type != BytesType
+}
+
+// HasNilPresence checks if the field is unset when it is nil. Pointers,
+// wrappers and bytes fields with presence may be present with a zero value.
+// Bytes fields with presence are not pointers, so an empty, non-nil value is
+// present.
+func (f *Field) HasNilPresence() bool {
+	return f.IsPointer() || f.IsWrapper || (f.HasPresence && f.Type == BytesType)
+}
+
+// RequiredRule returns the rule that ensures the field is populated. Fields
+// that track presence must be present, but may hold a zero value.
+func (f *Field) RequiredRule() string {
+	if f.HasNilPresence() {
+		return "validation.NotNil"
+	}
+
+	return "validation.Required"
+}
+
 func isMatch(a, b *Field) bool {
 	// Types must match in both fields.
 	if a.Type != b.Type {
 		return false
 	}
 
+	// Pointers and values can't be assigned to each other. Bytes fields
+	// tracking presence are assigned as is, but a zero value of a field
+	// without presence must not become present.
+	if a.IsPointer() != b.IsPointer() || a.HasNilPresence() != b.HasNilPresence() {
+		return false
+	}
+
 	// Never assume enum or oneof comparisions are equal to each other.
 	if a.Type == EnumType || a.Type == OneOfType {
 		return false
@@ -164,3 +282,18 @@ func isMatch(a, b *Field) bool {
 
 	return isMessageMatch(a.Message, b.Message)
 }
+
+// isPresenceConverted checks if two fields hold the same scalar value, but
+// track presence differently. For example, a proto3 `optional` field and a
+// `google.protobuf.StringValue` field.
+func isPresenceConverted(a, b *Field) bool {
+	if isMatch(a, b) || a.ValueType != b.ValueType {
+		return false
+	}
+
+	isScalar := func(f *Field) bool {
+		return !f.IsRepeated && (f.IsWrapper || (!f.IsMessage && !f.IsEnum))
+	}
+
+	return isScalar(a) && isScalar(b)
+}
@@ -0,0 +1,152 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// numberedField is a field of a message with the field it is converted to in
+// the next or private message.
+type numberedField struct {
+	Field   *Field
+	Target  *Field
+	Message *Message
+}
+
+// FieldByNumber returns the field, or member of a oneof, of the message with
+// the field number. Nil is returned when no field has the number.
+func (m *Message) FieldByNumber(n protoreflect.FieldNumber) *Field {
+	for _, f := range m.Fields {
+		fields := []*Field{f}
+		if f.IsOneOf {
+			fields = f.Members
+		}
+
+		for _, field := range fields {
+			if field.Number == n {
+				return field
+			}
+		}
+	}
+
+	return nil
+}
+
+// numberedFields returns the fields, and members of oneofs, of the message
+// that are converted to a field of the next message or the private message.
+// Fields moved to a field of a different message are not returned since their
+// numbers are not comparable.
+func numberedFields(msg *Message) []numberedField {
+	var fields []numberedField
+	for _, f := range msg.ConvertedFields() {
+		if f.IsNextMoved() || (f.Next == nil && f.IsPrivateMoved()) {
+			continue
+		}
+
+		members := []*Field{f}
+		if f.IsOneOf {
+			members = f.Members
+		}
+
+		for _, m := range members {
+			switch {
+			case m.Next != nil:
+				fields = append(fields, numberedField{Field: m, Target: m.Next, Message: msg.Next})
+			case m.Private != nil && msg.Private != nil:
+				fields = append(fields, numberedField{Field: m, Target: m.Private, Message: msg.Private})
+			}
+		}
+	}
+
+	return fields
+}
+
+// FieldNumberErrors compares the field numbers of each public message with the
+// next message and the private message it is converted to. An error is
+// returned for each field number reserved, or reused by a different field, in
+// the next message, and for each field number or name reserved by a message
+// and used by the next message. Private messages are numbered independently of
+// public messages, so only their reserved numbers and names are compared. A
+// field is allowed to be converted to a field with a different number.
+func FieldNumberErrors(r RegisterService) []error {
+	var errs []error
+	for _, svc := range r.Services {
+		for _, msg := range svc.Messages {
+			if msg.IsAlias || msg.IsExternal {
+				continue
+			}
+
+			errs = append(errs, fieldNumberErrors(msg)...)
+		}
+	}
+
+	return errs
+}
+
+// checkFieldNumbers reports the field number errors of the services in a mode
+// of the `field_numbers` parameter. The first error is returned in the error
+// mode. Errors are written to w as warnings in the warn mode, which is the
+// default mode.
+func checkFieldNumbers(w io.Writer, mode string, r RegisterService) error {
+	if mode == FieldNumbersIgnore {
+		return nil
+	}
+
+	for _, err := range FieldNumberErrors(r) {
+		if mode == FieldNumbersError {
+			return err
+		}
+
+		fmt.Fprintf(w, "warning: %v\n", err)
+	}
+
+	return nil
+}
+
+func fieldNumberErrors(msg *Message) []error {
+	var errs []error
+	for _, nf := range numberedFields(msg) {
+		if nf.Message.ReservedNumbers.Has(nf.Field.Number) {
+			errs = append(errs, NewErrFieldNumberReserved(nf.Field, nf.Message))
+			continue
+		}
+
+		if nf.Message.IsPrivate && nf.Message.ReservedNames.Has(protoreflect.Name(nf.Field.ProtoName)) {
+			errs = append(errs, NewErrFieldNameReserved(nf.Field, nf.Message))
+			continue
+		}
+
+		if nf.Message != msg.Next {
+			continue
+		}
+
+		if other := nf.Message.FieldByNumber(nf.Field.Number); other != nil && other != nf.Target {
+			errs = append(errs, NewErrFieldNumberReused(nf.Field, other))
+		}
+	}
+
+	// Fields of the next message must not use the numbers and names the
+	// message reserved.
+	if msg.Next == nil {
+		return errs
+	}
+
+	for _, f := range msg.Next.Fields {
+		fields := []*Field{f}
+		if f.IsOneOf {
+			fields = f.Members
+		}
+
+		for _, field := range fields {
+			if msg.ReservedNumbers.Has(field.Number) {
+				errs = append(errs, NewErrReservedFieldNumberReused(msg, field))
+			} else if msg.ReservedNames.Has(protoreflect.Name(field.ProtoName)) {
+				errs = append(errs, NewErrReservedFieldNameReused(msg, field))
+			}
+		}
+	}
+
+	return errs
+}
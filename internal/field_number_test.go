@@ -0,0 +1,250 @@
+package internal
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// reserved returns the reserved names and numbers of a message descriptor.
+func reserved(t *testing.T, numbers []int32, names ...string) (protoreflect.Names, protoreflect.FieldRanges) {
+	t.Helper()
+
+	msg := &descriptorpb.DescriptorProto{
+		Name:         proto.String("Reserved"),
+		ReservedName: names,
+	}
+
+	for _, n := range numbers {
+		msg.ReservedRange = append(msg.ReservedRange, &descriptorpb.DescriptorProto_ReservedRange{
+			Start: proto.Int32(n),
+			End:   proto.Int32(n + 1),
+		})
+	}
+
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:        proto.String("reserved.proto"),
+		Syntax:      proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{msg},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	md := fd.Messages().Get(0)
+	return md.ReservedNames(), md.ReservedRanges()
+}
+
+func testField(fullName string, number protoreflect.FieldNumber) *Field {
+	return &Field{
+		FullName:  fullName,
+		ProtoName: fullName[strings.LastIndex(fullName, ".")+1:],
+		Number:    number,
+	}
+}
+
+func numberedMessage(t *testing.T, fullName string, numbers []int32, names []string, fields ...*Field) *Message {
+	t.Helper()
+
+	msg := &Message{FullName: fullName, Fields: fields}
+	msg.ReservedNames, msg.ReservedNumbers = reserved(t, numbers, names...)
+	return msg
+}
+
+func numberedRegister(msgs ...*Message) RegisterService {
+	return RegisterService{Services: []*Service{{Messages: msgs}}}
+}
+
+func TestFieldNumberErrors(t *testing.T) {
+	tests := map[string]struct {
+		Register func(t *testing.T) RegisterService
+		Want     []string
+	}{
+		"renumbered field": {
+			Register: func(t *testing.T) RegisterService {
+				next := testField("v2.Person.name", 2)
+				v2 := numberedMessage(t, "v2.Person", nil, nil, next)
+				f := testField("v1.Person.name", 1)
+				f.Next = next
+				v1 := numberedMessage(t, "v1.Person", nil, nil, f)
+				v1.Next = v2
+				return numberedRegister(v1)
+			},
+		},
+		"number reserved by next message": {
+			Register: func(t *testing.T) RegisterService {
+				next := testField("v2.Person.name", 2)
+				v2 := numberedMessage(t, "v2.Person", []int32{1}, nil, next)
+				f := testField("v1.Person.name", 1)
+				f.Next = next
+				v1 := numberedMessage(t, "v1.Person", nil, nil, f)
+				v1.Next = v2
+				return numberedRegister(v1)
+			},
+			Want: []string{"number 1 of field v1.Person.name is reserved by message v2.Person"},
+		},
+		"number reserved by private message": {
+			Register: func(t *testing.T) RegisterService {
+				private := testField("private.Person.name", 2)
+				p := numberedMessage(t, "private.Person", []int32{1}, nil, private)
+				p.IsPrivate = true
+				f := testField("v1.Person.name", 1)
+				f.Private = private
+				v1 := numberedMessage(t, "v1.Person", nil, nil, f)
+				v1.Private = p
+				return numberedRegister(v1)
+			},
+			Want: []string{"number 1 of field v1.Person.name is reserved by message private.Person"},
+		},
+		"name reserved by private message": {
+			Register: func(t *testing.T) RegisterService {
+				private := testField("private.Person.full_name", 2)
+				p := numberedMessage(t, "private.Person", nil, []string{"name"}, private)
+				p.IsPrivate = true
+				f := testField("v1.Person.name", 1)
+				f.Private = private
+				v1 := numberedMessage(t, "v1.Person", nil, nil, f)
+				v1.Private = p
+				return numberedRegister(v1)
+			},
+			Want: []string{"name of field v1.Person.name is reserved by message private.Person"},
+		},
+		"number reused by next message": {
+			Register: func(t *testing.T) RegisterService {
+				next := testField("v2.Person.name", 2)
+				other := testField("v2.Person.address", 1)
+				v2 := numberedMessage(t, "v2.Person", nil, nil, next, other)
+				f := testField("v1.Person.name", 1)
+				f.Next = next
+				v1 := numberedMessage(t, "v1.Person", nil, nil, f)
+				v1.Next = v2
+				return numberedRegister(v1)
+			},
+			Want: []string{"number 1 of field v1.Person.name is reused by field v2.Person.address"},
+		},
+		"number reused by oneof member of next message": {
+			Register: func(t *testing.T) RegisterService {
+				next := testField("v2.Person.name", 2)
+				member := testField("v2.Person.email", 1)
+				oneOf := &Field{IsOneOf: true, Members: []*Field{member}}
+				v2 := numberedMessage(t, "v2.Person", nil, nil, next, oneOf)
+				f := testField("v1.Person.name", 1)
+				f.Next = next
+				v1 := numberedMessage(t, "v1.Person", nil, nil, f)
+				v1.Next = v2
+				return numberedRegister(v1)
+			},
+			Want: []string{"number 1 of field v1.Person.name is reused by field v2.Person.email"},
+		},
+		"moved field is not compared": {
+			Register: func(t *testing.T) RegisterService {
+				next := testField("v2.Person.Address.city", 1)
+				other := testField("v2.Person.name", 1)
+				v2 := numberedMessage(t, "v2.Person", nil, nil, other)
+				f := testField("v1.Person.city", 1)
+				f.Next = next
+				f.NextPath = []*Field{testField("v2.Person.address", 2)}
+				v1 := numberedMessage(t, "v1.Person", nil, nil, f)
+				v1.Next = v2
+				return numberedRegister(v1)
+			},
+		},
+		"reserved number reused by next message": {
+			Register: func(t *testing.T) RegisterService {
+				v2 := numberedMessage(t, "v2.Person", nil, nil, testField("v2.Person.age", 3))
+				v1 := numberedMessage(t, "v1.Person", []int32{3}, nil)
+				v1.Next = v2
+				return numberedRegister(v1)
+			},
+			Want: []string{"number 3 reserved by message v1.Person is reused by field v2.Person.age"},
+		},
+		"reserved name reused by next message": {
+			Register: func(t *testing.T) RegisterService {
+				v2 := numberedMessage(t, "v2.Person", nil, nil, testField("v2.Person.age", 3))
+				v1 := numberedMessage(t, "v1.Person", nil, []string{"age"})
+				v1.Next = v2
+				return numberedRegister(v1)
+			},
+			Want: []string{"name age reserved by message v1.Person is reused by field v2.Person.age"},
+		},
+		"alias message is not compared": {
+			Register: func(t *testing.T) RegisterService {
+				v2 := numberedMessage(t, "v2.Person", nil, nil, testField("v2.Person.age", 3))
+				v1 := numberedMessage(t, "v1.Person", []int32{3}, nil)
+				v1.Next = v2
+				v1.IsAlias = true
+				return numberedRegister(v1)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for _, err := range FieldNumberErrors(test.Register(t)) {
+				got = append(got, err.Error())
+			}
+
+			if strings.Join(got, "\n") != strings.Join(test.Want, "\n") {
+				t.Fatalf("got errors %q, want %q", got, test.Want)
+			}
+		})
+	}
+}
+
+func TestCheckFieldNumbers(t *testing.T) {
+	register := func(t *testing.T) RegisterService {
+		v2 := numberedMessage(t, "v2.Person", nil, nil, testField("v2.Person.age", 3))
+		v1 := numberedMessage(t, "v1.Person", []int32{3}, nil)
+		v1.Next = v2
+		return numberedRegister(v1)
+	}
+
+	const issue = "number 3 reserved by message v1.Person is reused by field v2.Person.age"
+
+	tests := map[string]struct {
+		Mode    string
+		Err     string
+		Warning string
+	}{
+		"default": {
+			Warning: "warning: " + issue + "\n",
+		},
+		"warn": {
+			Mode:    FieldNumbersWarn,
+			Warning: "warning: " + issue + "\n",
+		},
+		"error": {
+			Mode: FieldNumbersError,
+			Err:  issue,
+		},
+		"ignore": {
+			Mode: FieldNumbersIgnore,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var w bytes.Buffer
+			err := checkFieldNumbers(&w, test.Mode, register(t))
+
+			var got string
+			if err != nil {
+				got = err.Error()
+			}
+
+			if got != test.Err {
+				t.Fatalf("got error %q, want %q", got, test.Err)
+			}
+
+			if w.String() != test.Warning {
+				t.Fatalf("got warnings %q, want %q", w.String(), test.Warning)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+
+	"github.com/dane/protoc-gen-go-svc/gen/svc"
+)
+
+// presenceFile returns a file of a package with a `People.Get` method whose
+// input holds a field of the type with proto3 `optional` presence.
+func presenceFile(pkg string, typ descriptorpb.FieldDescriptorProto_Type, typeName string) *descriptorpb.FileDescriptorProto {
+	field := &descriptorpb.FieldDescriptorProto{
+		Name:           proto.String("value"),
+		JsonName:       proto.String("value"),
+		Number:         proto.Int32(1),
+		Label:          descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:           typ.Enum(),
+		OneofIndex:     proto.Int32(0),
+		Proto3Optional: proto.Bool(true),
+	}
+
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String(pkg + "/service.proto"),
+		Package: proto.String(pkg),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{
+			GoPackage: proto.String("example.com/" + pkg + ";" + pkg),
+		},
+		Dependency: []string{annotationsPath},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:      proto.String("GetRequest"),
+			Field:     []*descriptorpb.FieldDescriptorProto{field},
+			OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("_value")}},
+		}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("People"),
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("Get"),
+				InputType:  proto.String("." + pkg + ".GetRequest"),
+				OutputType: proto.String("." + pkg + ".GetRequest"),
+			}},
+		}},
+	}
+
+	proto.SetExtension(file.Options, svc.E_GoPackage, "example.com/service;service")
+
+	if typeName != "" {
+		field.TypeName = proto.String("." + pkg + "." + typeName)
+		file.EnumType = []*descriptorpb.EnumDescriptorProto{{
+			Name:  proto.String(typeName),
+			Value: []*descriptorpb.EnumValueDescriptorProto{{Name: proto.String("UNSET"), Number: proto.Int32(0)}},
+		}}
+	}
+
+	return file
+}
+
+func TestFieldPresence(t *testing.T) {
+	tests := map[string]struct {
+		Type     descriptorpb.FieldDescriptorProto_Type
+		TypeName string
+		Err      string
+	}{
+		"optional bytes": {
+			Type: descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+		},
+		"optional string": {
+			Type: descriptorpb.FieldDescriptorProto_TYPE_STRING,
+		},
+		"optional enum": {
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_ENUM,
+			TypeName: "Kind",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := &pluginpb.CodeGeneratorRequest{
+				FileToGenerate: []string{"private/service.proto", "v1/service.proto"},
+				ProtoFile: []*descriptorpb.FileDescriptorProto{
+					protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto),
+					annotationsFile(),
+					presenceFile("private", test.Type, test.TypeName),
+					presenceFile("v1", test.Type, test.TypeName),
+				},
+			}
+
+			plugin, err := protogen.Options{}.New(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = (&Plugin{}).Run(plugin)
+			if got := errString(err); !strings.Contains(got, test.Err) || (test.Err == "") != (err == nil) {
+				t.Fatalf("got error %q, want %q", got, test.Err)
+			}
+		})
+	}
+}
+
+func TestHasNilPresence(t *testing.T) {
+	tests := map[string]struct {
+		Field *Field
+		Want  bool
+		Rule  string
+	}{
+		"string": {
+			Field: &Field{Type: StringType, ValueType: StringType},
+			Rule:  "validation.Required",
+		},
+		"optional string": {
+			Field: &Field{Type: StringType, ValueType: StringType, HasPresence: true},
+			Want:  true,
+			Rule:  "validation.NotNil",
+		},
+		"bytes": {
+			Field: &Field{Type: BytesType, ValueType: BytesType},
+			Rule:  "validation.Required",
+		},
+		"optional bytes": {
+			Field: &Field{Type: BytesType, ValueType: BytesType, HasPresence: true},
+			Want:  true,
+			Rule:  "validation.NotNil",
+		},
+		"wrapper": {
+			Field: &Field{Type: MessageType, ValueType: BytesType, IsMessage: true, IsWrapper: true},
+			Want:  true,
+			Rule:  "validation.NotNil",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.Field.HasNilPresence(); got != test.Want {
+				t.Fatalf("got presence %t, want %t", got, test.Want)
+			}
+
+			if got := test.Field.RequiredRule(); got != test.Rule {
+				t.Fatalf("got rule %q, want %q", got, test.Rule)
+			}
+		})
+	}
+
+	// A zero value of bytes without presence must not become present.
+	a := &Field{Type: BytesType, ValueType: BytesType}
+	b := &Field{Type: BytesType, ValueType: BytesType, HasPresence: true}
+	if isMatch(a, b) || !isPresenceConverted(a, b) {
+		t.Fatal("expected bytes with and without presence to be presence converted")
+	}
+}
@@ -0,0 +1,213 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/dane/protoc-gen-go-svc/gen/svc"
+)
+
+// FuzzRule holds the validation rules of a public field, and the builtin
+// conversion of a string field. Fuzz tests generate random values satisfying
+// the rule so that inputs are not rejected by the service version.
+type FuzzRule struct {
+	Required bool
+	Min      string
+	Max      string
+	Is       string
+	In       []string
+	Builtin  string
+}
+
+// FuzzRule returns the fuzz rule of the field. Rules of the next and private
+// fields the field is converted to are merged, so that inputs are not rejected
+// later in the chain. Only the `required` rule of enum fields is merged since
+// enum values differ between versions. Nil is returned when no rule applies.
+func (f *Field) FuzzRule() *FuzzRule {
+	rule := &FuzzRule{In: f.Validate.GetIn()}
+	var min, max *float64
+
+	targets := []*Field{f}
+	for next := f.Next; next != nil; next = next.Next {
+		targets = append(targets, next)
+	}
+
+	if f.Private != nil {
+		targets = append(targets, f.Private)
+	}
+
+	conversion := f.ConvertNext
+	if conversion == nil {
+		conversion = f.ConvertPrivate
+	}
+
+	if f.Type == StringType && conversion != nil && conversion.Func == "" {
+		rule.Builtin = conversion.Builtin
+	}
+
+	for _, t := range targets {
+		// Minimums and maximums of integer fields apply to the value of a
+		// string field converted with the `INTEGER` builtin.
+		isInteger := rule.Builtin == svc.Convert_INTEGER.String() && isIntegerType(t)
+		if t.Type != f.Type && !isInteger {
+			continue
+		}
+
+		rule.Required = rule.Required || t.Validate.GetRequired()
+		if f.IsEnum {
+			continue
+		}
+
+		if v := t.Validate.GetMin(); v != nil && (min == nil || numberValue(v) > *min) {
+			n := numberValue(v)
+			min = &n
+		}
+
+		if v := t.Validate.GetMax(); v != nil && (max == nil || numberValue(v) < *max) {
+			n := numberValue(v)
+			max = &n
+		}
+
+		if is := t.Validate.GetIs(); rule.Is == "" && is != svc.Validate_UNSPECIFIED {
+			rule.Is = is.String()
+		}
+
+		if len(rule.In) == 0 && !isInteger {
+			rule.In = t.Validate.GetIn()
+		}
+	}
+
+	if min != nil {
+		rule.Min = strconv.FormatFloat(*min, 'g', -1, 64)
+	}
+
+	if max != nil {
+		rule.Max = strconv.FormatFloat(*max, 'g', -1, 64)
+	}
+
+	if !rule.Required && rule.Min == "" && rule.Max == "" && rule.Is == "" && len(rule.In) == 0 && rule.Builtin == "" {
+		return nil
+	}
+
+	return rule
+}
+
+// IsRoundTrip checks if the value of the field is preserved when converted to
+// the private service and back. The field, and each next field it is converted
+// through, must not be deprecated and must match the field it is converted to.
+func (f *Field) IsRoundTrip() bool {
+	if f.IsDeprecated {
+		return false
+	}
+
+	for cur := f; cur != nil; cur = cur.Next {
+		if !cur.IsMatch || !cur.isRoundTripConverted() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsRoundTripMessage checks if the field holds a message, or is a oneof of
+// messages, whose round trip fields are compared. The field, and each next
+// field it is converted through, must be converted by its own message.
+func (f *Field) IsRoundTripMessage() bool {
+	if f.IsDeprecated || f.IsMatch || (!f.IsOneOf && (!f.IsMessage || f.Message.IsExternal)) {
+		return false
+	}
+
+	for cur := f; cur != nil; cur = cur.Next {
+		if !cur.isRoundTripConverted() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RoundTripExclusion describes why the field is not compared after a round
+// trip, or is empty when it is compared.
+func (f *Field) RoundTripExclusion() string {
+	if f.IsRoundTrip() || f.IsRoundTripMessage() {
+		return ""
+	}
+
+	if f.IsDeprecated {
+		return fmt.Sprintf("%s is deprecated", f.FullName)
+	}
+
+	for cur := f; cur != nil; cur = cur.Next {
+		switch {
+		case cur.IsPagination:
+			return fmt.Sprintf("%s is a pagination field", cur.FullName)
+		case cur.IsComposed:
+			return fmt.Sprintf("%s is composed", cur.FullName)
+		case cur.IsMerged:
+			return fmt.Sprintf("%s is merged", cur.FullName)
+		case cur.IsNextMoved() || cur.IsPrivateMoved():
+			return fmt.Sprintf("%s is moved", cur.FullName)
+		case cur.ConvertPrivate != nil && cur.ConvertPrivate.Func != "":
+			return fmt.Sprintf("%s is converted by func %s", cur.FullName, cur.ConvertPrivate.Func)
+		case cur.ConvertPrivate != nil:
+			return fmt.Sprintf("%s is converted by builtin %s", cur.FullName, cur.ConvertPrivate.Builtin)
+		case cur.IsMatch:
+		case cur.IsEnum:
+			return fmt.Sprintf("%s has enum values received by other names", cur.FullName)
+		case cur.IsPresenceConverted:
+			return fmt.Sprintf("%s tracks presence differently", cur.FullName)
+		}
+	}
+
+	return fmt.Sprintf("%s is converted to a field of another type", f.FullName)
+}
+
+func (f *Field) isRoundTripConverted() bool {
+	return !f.IsPagination && !f.IsComposed && !f.IsMerged && !f.IsNextMoved() && !f.IsPrivateMoved() && f.ConvertPrivate == nil
+}
+
+// PublicConversion is a step converting the input of a method from the private
+// service to a service version. Steps of later service versions return the
+// next message of the step that follows.
+type PublicConversion struct {
+	Service      *Service
+	Message      *Message
+	IsDeprecated bool
+	IsLast       bool
+}
+
+// PublicConversions returns the steps converting the private input of a method
+// to the public input, starting from the last service version the method
+// calls.
+func (s *Service) PublicConversions(m *Method) []PublicConversion {
+	var steps []PublicConversion
+	for svc, method := s, m; ; svc, method = svc.Next, method.Next {
+		if method.Next == nil || method.Input.IsDeprecated {
+			steps = append(steps, PublicConversion{
+				Service:      svc,
+				Message:      method.Input,
+				IsDeprecated: !svc.IsLatest || method.Input.IsDeprecated,
+				IsLast:       true,
+			})
+			break
+		}
+
+		steps = append(steps, PublicConversion{Service: svc, Message: method.Input})
+	}
+
+	for i, j := 0, len(steps)-1; i < j; i, j = i+1, j-1 {
+		steps[i], steps[j] = steps[j], steps[i]
+	}
+
+	return steps
+}
+
+// Chain returns the service followed by each next service version.
+func (s *Service) Chain() []*Service {
+	var chain []*Service
+	for svc := s; svc != nil; svc = svc.Next {
+		chain = append(chain, svc)
+	}
+
+	return chain
+}
@@ -0,0 +1,103 @@
+package internal
+
+import "testing"
+
+func TestRoundTripExclusion(t *testing.T) {
+	tests := map[string]struct {
+		Field func() *Field
+		Want  string
+	}{
+		"compared": {
+			Field: func() *Field {
+				f := testField("v1.Person.name", 1)
+				f.IsMatch = true
+				f.Next = testField("v2.Person.name", 1)
+				f.Next.IsMatch = true
+				return f
+			},
+		},
+		"deprecated": {
+			Field: func() *Field {
+				f := testField("v1.Person.name", 1)
+				f.IsDeprecated = true
+				return f
+			},
+			Want: "v1.Person.name is deprecated",
+		},
+		"composed next field": {
+			Field: func() *Field {
+				f := testField("v1.SearchRequest.query", 1)
+				f.IsMatch = true
+				f.Next = testField("v2.SearchRequest.query", 1)
+				f.Next.IsMatch = true
+				f.Next.IsComposed = true
+				return f
+			},
+			Want: "v2.SearchRequest.query is composed",
+		},
+		"pagination": {
+			Field: func() *Field {
+				f := testField("v1.ListRequest.page_token", 1)
+				f.IsMatch = true
+				f.IsPagination = true
+				return f
+			},
+			Want: "v1.ListRequest.page_token is a pagination field",
+		},
+		"moved": {
+			Field: func() *Field {
+				f := testField("v1.Person.city", 1)
+				f.IsMatch = true
+				f.PrivatePath = []*Field{testField("private.Address.city", 1)}
+				return f
+			},
+			Want: "v1.Person.city is moved",
+		},
+		"func conversion": {
+			Field: func() *Field {
+				f := testField("v1.ListRequest.created_after", 1)
+				f.ConvertPrivate = &Conversion{Func: "Date"}
+				return f
+			},
+			Want: "v1.ListRequest.created_after is converted by func Date",
+		},
+		"builtin conversion": {
+			Field: func() *Field {
+				f := testField("v1.Person.age", 1)
+				f.ConvertPrivate = &Conversion{Builtin: "INTEGER"}
+				return f
+			},
+			Want: "v1.Person.age is converted by builtin INTEGER",
+		},
+		"enum": {
+			Field: func() *Field {
+				f := testField("v1.Person.employment", 1)
+				f.IsEnum = true
+				return f
+			},
+			Want: "v1.Person.employment has enum values received by other names",
+		},
+		"presence": {
+			Field: func() *Field {
+				f := testField("v1.Person.nickname", 1)
+				f.IsPresenceConverted = true
+				return f
+			},
+			Want: "v1.Person.nickname tracks presence differently",
+		},
+		"another type": {
+			Field: func() *Field {
+				return testField("v1.Person.name", 1)
+			},
+			Want: "v1.Person.name is converted to a field of another type",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := test.Field().RoundTripExclusion(); got != test.Want {
+				t.Fatalf("got %q, want %q", got, test.Want)
+			}
+		})
+	}
+}
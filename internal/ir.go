@@ -0,0 +1,392 @@
+package internal
+
+import (
+	"encoding/json"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// IRVersion is the version of the intermediate representation written by the
+// `ir` plugin parameter. It is incremented when a key is removed or changes
+// meaning. New keys may be added without changing the version.
+const IRVersion = 1
+
+// IR is the intermediate representation of a chain. Services, methods,
+// messages, fields and enum values refer to each other by their full proto
+// names rather than by pointers, so the IR can be consumed by other tools.
+type IR struct {
+	Version     int         `json:"version"`
+	PackageName string      `json:"package_name"`
+	ImportPath  string      `json:"import_path"`
+	Services    []IRService `json:"services"`
+}
+
+type IRService struct {
+	Package        string      `json:"package"`
+	GoPackage      string      `json:"go_package"`
+	ImportPath     string      `json:"import_path"`
+	Name           string      `json:"name"`
+	IsPrivate      bool        `json:"is_private"`
+	IsLatest       bool        `json:"is_latest"`
+	NextPackage    string      `json:"next_package,omitempty"`
+	PrivatePackage string      `json:"private_package,omitempty"`
+	Methods        []IRMethod  `json:"methods"`
+	Messages       []IRMessage `json:"messages"`
+}
+
+type IRMethod struct {
+	Name         string        `json:"name"`
+	FullName     string        `json:"full_name"`
+	Comments     string        `json:"comments,omitempty"`
+	Input        string        `json:"input"`
+	Output       string        `json:"output"`
+	IsDeprecated bool          `json:"is_deprecated"`
+	IsHook       bool          `json:"is_hook"`
+	Next         string        `json:"next,omitempty"`
+	Private      string        `json:"private,omitempty"`
+	Pagination   *IRPagination `json:"pagination,omitempty"`
+	Alias        *IRAlias      `json:"alias,omitempty"`
+}
+
+type IRPagination struct {
+	Style         string `json:"style"`
+	MaxPageSize   int32  `json:"max_page_size,omitempty"`
+	PageSize      string `json:"page_size,omitempty"`
+	PageToken     string `json:"page_token,omitempty"`
+	NextPageToken string `json:"next_page_token,omitempty"`
+	Offset        string `json:"offset,omitempty"`
+	Limit         string `json:"limit,omitempty"`
+	Results       string `json:"results,omitempty"`
+}
+
+type IRAlias struct {
+	Method      string `json:"method"`
+	Input       string `json:"input"`
+	Output      string `json:"output"`
+	Concurrency uint32 `json:"concurrency,omitempty"`
+}
+
+type IRMessage struct {
+	FullName     string          `json:"full_name"`
+	Comments     string          `json:"comments,omitempty"`
+	IsDeprecated bool            `json:"is_deprecated"`
+	IsExternal   bool            `json:"is_external"`
+	IsAlias      bool            `json:"is_alias"`
+	Next         string          `json:"next,omitempty"`
+	Private      string          `json:"private,omitempty"`
+	Fields       []IRField       `json:"fields"`
+	Compositions []IRComposition `json:"compositions,omitempty"`
+}
+
+type IRField struct {
+	Name           string          `json:"name"`
+	JSONName       string          `json:"json_name,omitempty"`
+	FullName       string          `json:"full_name"`
+	Comments       string          `json:"comments,omitempty"`
+	Type           string          `json:"type"`
+	Message        string          `json:"message,omitempty"`
+	IsRepeated     bool            `json:"is_repeated"`
+	IsRequired     bool            `json:"is_receive_required"`
+	IsDeprecated   bool            `json:"is_deprecated"`
+	HasPresence    bool            `json:"has_presence"`
+	IsWrapper      bool            `json:"is_wrapper"`
+	IsMerged       bool            `json:"is_merged"`
+	Next           string          `json:"next,omitempty"`
+	Private        string          `json:"private,omitempty"`
+	NextPath       *IRFieldPath    `json:"next_path,omitempty"`
+	PrivatePath    *IRFieldPath    `json:"private_path,omitempty"`
+	ConvertNext    *IRConversion   `json:"convert_next,omitempty"`
+	ConvertPrivate *IRConversion   `json:"convert_private,omitempty"`
+	Validate       json.RawMessage `json:"validate,omitempty"`
+	Rules          []string        `json:"rules,omitempty"`
+	Members        []IRField       `json:"members,omitempty"`
+	EnumValues     []IREnumValue   `json:"enum_values,omitempty"`
+}
+
+// IRFieldPath is the path of fields a field is moved to in the next or private
+// message, ending with the field holding the value. The path starts at the
+// parent of the message when `is_parent` is set.
+type IRFieldPath struct {
+	Fields   []string `json:"fields"`
+	IsParent bool     `json:"is_parent,omitempty"`
+}
+
+type IRConversion struct {
+	Builtin string `json:"builtin,omitempty"`
+	Func    string `json:"func,omitempty"`
+}
+
+type IRComposition struct {
+	Fields    []string `json:"fields"`
+	Into      string   `json:"into"`
+	Separator string   `json:"separator,omitempty"`
+	Format    string   `json:"format,omitempty"`
+	Func      string   `json:"func,omitempty"`
+}
+
+type IREnumValue struct {
+	FullName     string   `json:"full_name"`
+	IsDeprecated bool     `json:"is_deprecated"`
+	Next         string   `json:"next,omitempty"`
+	Private      string   `json:"private,omitempty"`
+	Receive      []string `json:"receive,omitempty"`
+}
+
+// NewIR creates the intermediate representation of a chain. Private services
+// are listed before public services, which are listed from the latest
+// version.
+func NewIR(importPath string, r RegisterService) (IR, error) {
+	ir := IR{
+		Version:     IRVersion,
+		PackageName: r.PackageName,
+		ImportPath:  importPath,
+	}
+
+	for _, svc := range append(append([]*Service{}, r.Privates...), r.Services...) {
+		s, err := newIRService(svc)
+		if err != nil {
+			return IR{}, err
+		}
+
+		ir.Services = append(ir.Services, s)
+	}
+
+	return ir, nil
+}
+
+func newIRService(svc *Service) (IRService, error) {
+	s := IRService{
+		Package:    svc.ProtoPackageName,
+		GoPackage:  svc.PackageName,
+		ImportPath: svc.ImportPath,
+		Name:       svc.Name,
+		IsPrivate:  svc.IsPrivate,
+		IsLatest:   svc.IsLatest,
+		Methods:    []IRMethod{},
+		Messages:   []IRMessage{},
+	}
+
+	if svc.Next != nil {
+		s.NextPackage = svc.Next.ProtoPackageName
+	}
+
+	if svc.Private != nil {
+		s.PrivatePackage = svc.Private.ProtoPackageName
+	}
+
+	for _, m := range svc.Methods {
+		s.Methods = append(s.Methods, newIRMethod(m))
+	}
+
+	for _, msg := range svc.Messages {
+		m, err := newIRMessage(msg)
+		if err != nil {
+			return IRService{}, err
+		}
+
+		s.Messages = append(s.Messages, m)
+	}
+
+	return s, nil
+}
+
+func newIRMethod(m *Method) IRMethod {
+	method := IRMethod{
+		Name:         m.Name,
+		FullName:     m.FullName,
+		Comments:     m.Comments,
+		Input:        m.Input.FullName,
+		Output:       m.Output.FullName,
+		IsDeprecated: m.IsDeprecated,
+		IsHook:       m.IsHook,
+	}
+
+	if m.Next != nil {
+		method.Next = m.Next.FullName
+	}
+
+	if m.Private != nil {
+		method.Private = m.Private.FullName
+	}
+
+	if p := m.Pagination; p != nil {
+		method.Pagination = &IRPagination{
+			Style:         "OFFSET",
+			MaxPageSize:   p.MaxPageSize,
+			PageSize:      irFieldName(p.PageSize),
+			PageToken:     irFieldName(p.PageToken),
+			NextPageToken: irFieldName(p.NextPageToken),
+			Offset:        irFieldName(p.Offset),
+			Limit:         irFieldName(p.Limit),
+			Results:       irFieldName(p.Results),
+		}
+
+		if p.IsToken {
+			method.Pagination.Style = "TOKEN"
+		}
+	}
+
+	if a := m.Alias; a != nil {
+		method.Alias = &IRAlias{
+			Method:      a.Method.FullName,
+			Input:       a.Input.ProtoName,
+			Output:      a.Output.ProtoName,
+			Concurrency: a.Concurrency,
+		}
+	}
+
+	return method
+}
+
+func newIRMessage(msg *Message) (IRMessage, error) {
+	m := IRMessage{
+		FullName:     msg.FullName,
+		Comments:     msg.Comments,
+		IsDeprecated: msg.IsDeprecated,
+		IsExternal:   msg.IsExternal,
+		IsAlias:      msg.IsAlias,
+		Fields:       []IRField{},
+	}
+
+	if msg.Next != nil {
+		m.Next = msg.Next.FullName
+	}
+
+	if msg.Private != nil {
+		m.Private = msg.Private.FullName
+	}
+
+	for _, f := range msg.Fields {
+		field, err := newIRField(f)
+		if err != nil {
+			return IRMessage{}, err
+		}
+
+		m.Fields = append(m.Fields, field)
+	}
+
+	for _, c := range msg.Compositions {
+		composition := IRComposition{
+			Into:      c.Into.ProtoName,
+			Separator: c.Separator,
+			Format:    c.Format,
+			Func:      c.Func,
+		}
+
+		for _, f := range c.Fields {
+			composition.Fields = append(composition.Fields, f.ProtoName)
+		}
+
+		m.Compositions = append(m.Compositions, composition)
+	}
+
+	return m, nil
+}
+
+func newIRField(f *Field) (IRField, error) {
+	field := IRField{
+		Name:           f.ProtoName,
+		JSONName:       f.JSONName,
+		FullName:       f.FullName,
+		Comments:       f.Comments,
+		Type:           f.Type.String(),
+		IsRepeated:     f.IsRepeated,
+		IsRequired:     f.IsRequired,
+		IsDeprecated:   f.IsDeprecated,
+		HasPresence:    f.HasPresence,
+		IsWrapper:      f.IsWrapper,
+		IsMerged:       f.IsMerged,
+		ConvertNext:    newIRConversion(f.ConvertNext),
+		ConvertPrivate: newIRConversion(f.ConvertPrivate),
+	}
+
+	if f.Message != nil {
+		field.Message = f.Message.FullName
+	}
+
+	if f.Next != nil {
+		field.Next = f.Next.FullName
+	}
+
+	if f.Private != nil {
+		field.Private = f.Private.FullName
+	}
+
+	if f.Next != nil && f.IsNextMoved() {
+		field.NextPath = newIRFieldPath(append(append([]*Field{}, f.NextPath...), f.Next), f.IsNextParentPath)
+	}
+
+	if f.Private != nil && f.IsPrivateMoved() {
+		field.PrivatePath = newIRFieldPath(append(append([]*Field{}, f.PrivatePath...), f.Private), f.IsPrivateParentPath)
+	}
+
+	field.Rules = f.Rules
+
+	if f.Validate != nil {
+		b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(f.Validate)
+		if err != nil {
+			return IRField{}, err
+		}
+
+		if string(b) != "{}" {
+			field.Validate = b
+		}
+	}
+
+	for _, member := range f.Members {
+		m, err := newIRField(member)
+		if err != nil {
+			return IRField{}, err
+		}
+
+		field.Members = append(field.Members, m)
+	}
+
+	for _, v := range f.EnumValues {
+		value := IREnumValue{
+			FullName:     v.FullName,
+			IsDeprecated: v.IsDeprecated,
+		}
+
+		if v.Next != nil {
+			value.Next = v.Next.FullName
+		}
+
+		if v.Private != nil {
+			value.Private = v.Private.FullName
+		}
+
+		for _, r := range v.Receive {
+			value.Receive = append(value.Receive, r.FullName)
+		}
+
+		field.EnumValues = append(field.EnumValues, value)
+	}
+
+	return field, nil
+}
+
+func newIRFieldPath(fields []*Field, isParent bool) *IRFieldPath {
+	path := &IRFieldPath{IsParent: isParent}
+	for _, f := range fields {
+		path.Fields = append(path.Fields, f.FullName)
+	}
+
+	return path
+}
+
+func newIRConversion(c *Conversion) *IRConversion {
+	if c == nil {
+		return nil
+	}
+
+	return &IRConversion{Builtin: c.Builtin, Func: c.Func}
+}
+
+func irFieldName(f *Field) string {
+	if f == nil {
+		return ""
+	}
+
+	return f.ProtoName
+}
@@ -0,0 +1,160 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// wellKnownTSTypes are the TypeScript types of the JSON representation of
+// well-known messages that are not wrappers, keyed by message full name.
+var wellKnownTSTypes = map[string]string{
+	"google.protobuf.Timestamp": "string",
+	"google.protobuf.Duration":  "string",
+	"google.protobuf.FieldMask": "string",
+	"google.protobuf.Empty":     "Record<string, never>",
+	"google.protobuf.Struct":    "Record<string, unknown>",
+	"google.protobuf.ListValue": "unknown[]",
+}
+
+// jsString quotes a string as a JavaScript string literal.
+func jsString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+// jsPath returns the expression reading the last field of a path of `src`.
+// Optional chaining is used to read through unset messages.
+func jsPath(src string, fields []*Field) string {
+	for _, f := range fields {
+		src = fmt.Sprintf("%s?.%s", src, f.JSONName)
+	}
+
+	return src
+}
+
+// jsComment formats paragraphs of text as a JSDoc comment indented by
+// `indent`. Empty paragraphs are skipped.
+func jsComment(indent string, paragraphs ...string) string {
+	var lines []string
+	for _, p := range paragraphs {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+
+		if len(lines) > 0 {
+			lines = append(lines, indent+" *")
+		}
+
+		for _, line := range strings.Split(p, "\n") {
+			lines = append(lines, strings.TrimRight(indent+" * "+line, " "))
+		}
+	}
+
+	if len(lines) == 0 {
+		return ""
+	}
+
+	return indent + "/**\n" + strings.Join(lines, "\n") + "\n" + indent + " */"
+}
+
+// jsKeys returns the JSON names of a path of fields as a JavaScript array.
+func jsKeys(fields []*Field) string {
+	keys := make([]string, len(fields))
+	for i, f := range fields {
+		keys[i] = jsString(f.JSONName)
+	}
+
+	return "[" + strings.Join(keys, ", ") + "]"
+}
+
+// JSONName returns the name of the enum value in the JSON representation of
+// its message.
+func (v *EnumValue) JSONName() string {
+	return v.FullName[strings.LastIndex(v.FullName, ".")+1:]
+}
+
+// TSType returns the TypeScript type of the message in the JSON representation
+// of its parent message. The interfaces of the service version are imported as
+// `alias`. External messages are typed by their JSON representation when they
+// are well-known.
+func (m *Message) TSType(alias string) string {
+	if !m.IsExternal {
+		return alias + "." + m.Ref()
+	}
+
+	if t, ok := wellKnownTSTypes[m.FullName]; ok {
+		return t
+	}
+
+	if t, ok := wrapperTypes[protoreflect.FullName(m.FullName)]; ok {
+		return scalarTSType(t)
+	}
+
+	return "unknown"
+}
+
+// TSType returns the TypeScript type of the field in the JSON representation
+// of its message. 64-bit integers and bytes are represented as strings, and
+// wrapper messages as the value they wrap.
+func (f *Field) TSType() string {
+	// Members of oneofs are messages without a value type.
+	valueType := f.ValueType
+	if valueType == Undefined {
+		valueType = f.Type
+	}
+
+	var t string
+	switch valueType {
+	case EnumType:
+		names := make([]string, len(f.EnumValues))
+		for i, v := range f.EnumValues {
+			names[i] = jsString(v.JSONName())
+		}
+		t = strings.Join(names, " | ")
+	case MessageType:
+		if f.Message.IsExternal {
+			t = f.Message.TSType("")
+		} else {
+			t = f.Message.Ref()
+		}
+	default:
+		t = scalarTSType(valueType)
+	}
+
+	if f.IsRepeated {
+		return fmt.Sprintf("Array<%s>", t)
+	}
+
+	return t
+}
+
+// scalarTSType returns the TypeScript type of the JSON representation of a
+// scalar value.
+func scalarTSType(t Type) string {
+	switch t {
+	case StringType, Int64Type, Uint64Type, BytesType:
+		return "string"
+	case Int32Type, Float64Type:
+		return "number"
+	case BooleanType:
+		return "boolean"
+	default:
+		return "unknown"
+	}
+}
+
+// TSImportPath returns the module path of the message interfaces of another
+// service version relative to the JavaScript files of the service. Declaration
+// files are imported by the path of the JavaScript module they declare.
+func (s *Service) TSImportPath(other *Service) string {
+	module := strings.TrimSuffix(MessagesTSFileName, ".d.ts") + ".js"
+	if other == s {
+		return "./" + module
+	}
+
+	return path.Join("..", other.PackageName, module)
+}
@@ -0,0 +1,151 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/dane/protoc-gen-go-svc/gen/svc"
+)
+
+// Lint checks the services of a chain for annotations that generate valid
+// code, but are likely mistakes. Each issue is described by a line naming the
+// proto element. Annotations that cannot be generated are errors returned when
+// the chain is built.
+func Lint(r RegisterService) []string {
+	var issues []string
+	seen := make(map[string]bool)
+	add := func(format string, args ...interface{}) {
+		issue := fmt.Sprintf(format, args...)
+		if !seen[issue] {
+			seen[issue] = true
+			issues = append(issues, issue)
+		}
+	}
+
+	for _, svc := range append(append([]*Service{}, r.Privates...), r.Services...) {
+		for _, msg := range svc.Messages {
+			for _, f := range msg.Fields {
+				lintValidate(f, add)
+				if f.IsEnum && f.IsPrivate {
+					lintPrivateEnum(f, add)
+				} else if f.IsEnum {
+					lintEnum(f, add)
+				}
+			}
+		}
+
+		if !svc.IsPrivate && !svc.IsLatest {
+			lintForwarding(svc, add)
+		}
+	}
+
+	return issues
+}
+
+// lintValidate reports validation rules that no value can satisfy.
+func lintValidate(f *Field, add func(string, ...interface{})) {
+	min, max := f.Validate.GetMin(), f.Validate.GetMax()
+	if min == nil || max == nil {
+		return
+	}
+
+	if numberValue(min) > numberValue(max) {
+		add("%s: validate min %v is greater than max %v", f.FullName, numberValue(min), numberValue(max))
+	}
+}
+
+func numberValue(n *svc.Number) float64 {
+	switch v := n.GetValue().(type) {
+	case *svc.Number_Int64:
+		return float64(v.Int64)
+	case *svc.Number_Uint64:
+		return float64(v.Uint64)
+	case *svc.Number_Double:
+		return v.Double
+	}
+
+	return 0
+}
+
+// lintEnum reports receive annotations that have no effect and enum values of
+// the next or private enum that are not received. Converting an enum value
+// that is not received to the public service fails.
+func lintEnum(f *Field, add func(string, ...interface{})) {
+	target := f.Next
+	if target == nil {
+		target = f.Private
+	}
+
+	receivedBy := make(map[string]*EnumValue)
+	for _, v := range f.EnumValues {
+		if v.ReceiveName != "" {
+			add("%s: receive name %q is ignored, set receive names instead", v.FullName, v.ReceiveName)
+		}
+
+		for _, r := range v.Receive {
+			if prev, ok := receivedBy[r.FullName]; ok && prev != v {
+				add("%s: receives %s, which is already received by %s", v.FullName, r.FullName, prev.FullName)
+				continue
+			}
+
+			receivedBy[r.FullName] = v
+		}
+	}
+
+	if target == nil {
+		return
+	}
+
+	for _, v := range target.EnumValues {
+		if _, ok := receivedBy[v.FullName]; !ok {
+			add("%s: not received by any value of %s", v.FullName, f.FullName)
+		}
+	}
+}
+
+// lintPrivateEnum reports receive names of private enum values. Private enum
+// values are not converted, so the names are never received.
+func lintPrivateEnum(f *Field, add func(string, ...interface{})) {
+	for _, v := range f.EnumValues {
+		for _, name := range v.UnusedReceiveNames {
+			add("%s: receive name %q is unused, private enum values are not converted", v.FullName, name)
+		}
+	}
+}
+
+// lintForwarding reports deprecated fields of messages nested in the input of
+// a method calling the next service. Only deprecated fields of the input are
+// forwarded to the private service, so nested deprecated fields are dropped.
+func lintForwarding(svc *Service, add func(string, ...interface{})) {
+	for _, m := range svc.Methods {
+		if m.Next == nil || m.IsAlias || m.IsHook || m.IsDeprecated {
+			continue
+		}
+
+		visited := map[*Message]bool{m.Input: true}
+		var walk func(msg *Message)
+		walk = func(msg *Message) {
+			for _, f := range msg.ConvertedFields() {
+				fields := []*Field{f}
+				if f.IsOneOf {
+					fields = f.Members
+				}
+
+				for _, field := range fields {
+					if msg != m.Input && field.IsDeprecated {
+						add("%s: deprecated, but never forwarded to the private service by %s", field.FullName, m.FullName)
+						continue
+					}
+
+					if field.IsDeprecated || !field.IsMessage || field.Message.IsExternal || visited[field.Message] {
+						continue
+					}
+
+					visited[field.Message] = true
+					walk(field.Message)
+				}
+			}
+		}
+
+		walk(m.Input)
+	}
+}
@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dane/protoc-gen-go-svc/gen/svc"
+)
+
+func int64Number(n int64) *svc.Number {
+	return &svc.Number{Value: &svc.Number_Int64{Int64: n}}
+}
+
+func enumValue(fullName string, receive ...*EnumValue) *EnumValue {
+	return &EnumValue{
+		Name:     fullName[strings.LastIndex(fullName, ".")+1:],
+		FullName: fullName,
+		Receive:  receive,
+	}
+}
+
+func enumField(fullName string, next *Field, values ...*EnumValue) *Field {
+	f := testField(fullName, 1)
+	f.IsEnum = true
+	f.Next = next
+	f.EnumValues = values
+	return f
+}
+
+func lintRegister(services ...*Service) RegisterService {
+	return RegisterService{Services: services}
+}
+
+func TestLint(t *testing.T) {
+	tests := map[string]struct {
+		Register func() RegisterService
+		Want     []string
+	}{
+		"valid": {
+			Register: func() RegisterService {
+				f := testField("v1.Person.name", 1)
+				f.Validate = &svc.Validate{Min: int64Number(2), Max: int64Number(10)}
+				return lintRegister(&Service{IsLatest: true, Messages: []*Message{{Fields: []*Field{f}}}})
+			},
+		},
+		"min greater than max": {
+			Register: func() RegisterService {
+				f := testField("v1.Person.name", 1)
+				f.Validate = &svc.Validate{Min: int64Number(10), Max: int64Number(2)}
+				return lintRegister(&Service{IsLatest: true, Messages: []*Message{{Fields: []*Field{f}}}})
+			},
+			Want: []string{"v1.Person.name: validate min 10 is greater than max 2"},
+		},
+		"duplicate receives": {
+			Register: func() RegisterService {
+				fullTime := enumValue("v2.Person.FULL_TIME")
+				next := enumField("v2.Person.employment", nil, fullTime)
+				f := enumField("v1.Person.employment", next,
+					enumValue("v1.Person.EMPLOYED", fullTime),
+					enumValue("v1.Person.WORKING", fullTime),
+				)
+
+				return lintRegister(&Service{Messages: []*Message{{Fields: []*Field{f}}}})
+			},
+			Want: []string{"v1.Person.WORKING: receives v2.Person.FULL_TIME, which is already received by v1.Person.EMPLOYED"},
+		},
+		"unreceived next enum values": {
+			Register: func() RegisterService {
+				fullTime := enumValue("v2.Person.FULL_TIME")
+				next := enumField("v2.Person.employment", nil, fullTime, enumValue("v2.Person.PART_TIME"))
+				f := enumField("v1.Person.employment", next, enumValue("v1.Person.EMPLOYED", fullTime))
+				return lintRegister(&Service{Messages: []*Message{{Fields: []*Field{f}}}})
+			},
+			Want: []string{"v2.Person.PART_TIME: not received by any value of v1.Person.employment"},
+		},
+		"ignored receive name": {
+			Register: func() RegisterService {
+				v := enumValue("v1.Person.EMPLOYED")
+				v.ReceiveName = "FULL_TIME"
+				f := enumField("v1.Person.employment", nil, v)
+				return lintRegister(&Service{Messages: []*Message{{Fields: []*Field{f}}}})
+			},
+			Want: []string{`v1.Person.EMPLOYED: receive name "FULL_TIME" is ignored, set receive names instead`},
+		},
+		"unused receive names": {
+			Register: func() RegisterService {
+				v := enumValue("private.Person.FULL_TIME")
+				v.UnusedReceiveNames = []string{"EMPLOYED"}
+				f := enumField("private.Person.employment", nil, v)
+				f.IsPrivate = true
+				return RegisterService{Privates: []*Service{{IsPrivate: true, Messages: []*Message{{Fields: []*Field{f}}}}}}
+			},
+			Want: []string{`private.Person.FULL_TIME: receive name "EMPLOYED" is unused, private enum values are not converted`},
+		},
+		"deprecated nested inputs": {
+			Register: func() RegisterService {
+				city := testField("v1.Address.city", 1)
+				city.IsDeprecated = true
+				address := &Message{FullName: "v1.Address", Fields: []*Field{city}}
+
+				nickname := testField("v1.CreateRequest.nickname", 2)
+				nickname.IsDeprecated = true
+				field := testField("v1.CreateRequest.address", 1)
+				field.IsMessage = true
+				field.Message = address
+				input := &Message{FullName: "v1.CreateRequest", Fields: []*Field{field, nickname}}
+
+				return lintRegister(&Service{Methods: []*Method{{
+					FullName: "v1.People.Create",
+					Next:     &Method{},
+					Input:    input,
+				}}})
+			},
+			Want: []string{"v1.Address.city: deprecated, but never forwarded to the private service by v1.People.Create"},
+		},
+		"deprecated nested inputs of the latest service": {
+			Register: func() RegisterService {
+				city := testField("v1.Address.city", 1)
+				city.IsDeprecated = true
+				address := &Message{FullName: "v1.Address", Fields: []*Field{city}}
+
+				field := testField("v1.CreateRequest.address", 1)
+				field.IsMessage = true
+				field.Message = address
+				input := &Message{FullName: "v1.CreateRequest", Fields: []*Field{field}}
+
+				return lintRegister(&Service{IsLatest: true, Methods: []*Method{{
+					FullName: "v1.People.Create",
+					Private:  &Method{},
+					Input:    input,
+				}}})
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := Lint(test.Register())
+			if strings.Join(got, "\n") != strings.Join(test.Want, "\n") {
+				t.Fatalf("got issues %q, want %q", got, test.Want)
+			}
+		})
+	}
+}
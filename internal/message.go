@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
 	"github.com/dane/protoc-gen-go-svc/internal/options"
 )
@@ -18,16 +19,67 @@ type Message struct {
 	IsConverterEmpty bool
 	IsMatch          bool
 	IsInput          bool
+	IsAlias          bool
 	Name             string
 	MethodName       string
 	ImportPath       string
 	PackageName      string
 	FullName         string
+	Comments         string
 	Private          *Message
 	Next             *Message
 	Parent           *Message
 	Fields           []*Field
 	FieldByName      map[string]*Field
+
+	// PaginationFieldNames are the names of fields used by a paginated method.
+	// They are converted by the method rather than the message.
+	PaginationFieldNames map[string]bool
+
+	// ComposedFieldNames are the names of fields composed into a field of the
+	// next message. They are converted by the composition rather than the
+	// field.
+	ComposedFieldNames map[string]bool
+	Compositions       []*Composition
+
+	// ReservedNames and ReservedNumbers are the field names and numbers
+	// reserved by the message.
+	ReservedNames   protoreflect.Names       `json:"-"`
+	ReservedNumbers protoreflect.FieldRanges `json:"-"`
+
+	// IsPrivateShared is true when other messages of the service are converted
+	// to the same private message.
+	IsPrivateShared bool
+}
+
+// ConvertedFields returns the fields that are converted by the message
+// converters. Pagination fields are excluded.
+func (m *Message) ConvertedFields() []*Field {
+	var fields []*Field
+	for _, f := range m.Fields {
+		if !f.IsPagination {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// HasDeprecatedConversions checks if a deprecated field of the message is
+// converted to a private field of a different type, such as a message or enum,
+// or moved to a field of a different private message.
+func (m *Message) HasDeprecatedConversions() bool {
+	for _, f := range m.ConvertedFields() {
+		if !f.IsDeprecated {
+			continue
+		}
+
+		if f.ConvertPrivate != nil || f.IsPrivateMoved() || (!f.IsMatch && !f.IsPresenceConverted) {
+			return true
+		}
+	}
+
+	return false
 }
 
 func (m *Message) Type() string {
@@ -95,29 +147,41 @@ func NewMessage(svc *Service, message, parent *protogen.Message) (*Message, erro
 	}
 
 	msg := &Message{
-		IsPrivate:        svc.IsPrivate,
-		IsLatest:         svc.IsLatest,
-		IsDeprecated:     options.IsDeprecatedMessage(message),
-		IsOneOf:          len(message.Oneofs) > 0,
-		IsConverterEmpty: options.IsConverterEmpty(message),
-		ImportPath:       svc.ImportPath,
-		Name:             message.GoIdent.GoName,
-		FieldByName:      make(map[string]*Field),
-		Parent:           p,
-		FullName:         string(message.Desc.FullName()),
+		IsPrivate:            svc.IsPrivate,
+		IsLatest:             svc.IsLatest,
+		IsDeprecated:         options.IsDeprecatedMessage(message),
+		IsOneOf:              hasOneOf(message),
+		IsConverterEmpty:     options.IsConverterEmpty(message),
+		ImportPath:           svc.ImportPath,
+		Name:                 message.GoIdent.GoName,
+		FieldByName:          make(map[string]*Field),
+		PaginationFieldNames: make(map[string]bool),
+		ComposedFieldNames:   make(map[string]bool),
+		Parent:               p,
+		FullName:             string(message.Desc.FullName()),
+		Comments:             commentText(message.Comments.Leading),
+		ReservedNames:        message.Desc.ReservedNames(),
+		ReservedNumbers:      message.Desc.ReservedRanges(),
 	}
 
+	// Messages of alias methods, and their nested messages, are not converted.
+	msg.IsAlias = svc.AliasMessageNames[msg.FullName] || (p != nil && p.IsAlias)
+
 	// Private messages are the last in the service chain.
-	if msg.IsPrivate {
+	if msg.IsPrivate || msg.IsAlias {
 		return msg, nil
 	}
 
 	messageName := options.MessageName(message)
 
 	// Messages of the latest service or deprecated messages read/write directly
-	// to the private service.
+	// to the private service. Names with a leading "." are resolved from the
+	// private package rather than the parent message, allowing a message to be
+	// converted to a nested message of any private message.
 	if msg.IsLatest || msg.IsDeprecated {
-		if msg.Parent == nil {
+		if isAbsoluteMessageName(messageName) {
+			messageName = buildMessageKey(svc.Private, strings.TrimPrefix(messageName, "."))
+		} else if msg.Parent == nil {
 			messageName = buildMessageKey(svc.Private, messageName)
 		} else {
 			messageName = fmt.Sprintf("%s.%s", msg.Parent.Private.FullName, messageName)
@@ -134,7 +198,9 @@ func NewMessage(svc *Service, message, parent *protogen.Message) (*Message, erro
 	}
 
 	// All other messages will chain to a message in the next service version.
-	if msg.Parent == nil {
+	if isAbsoluteMessageName(messageName) {
+		messageName = buildMessageKey(svc.Next, strings.TrimPrefix(messageName, "."))
+	} else if msg.Parent == nil {
 		messageName = buildMessageKey(svc.Next, messageName)
 	} else {
 		messageName = fmt.Sprintf("%s.%s", msg.Parent.Next.FullName, messageName)
@@ -160,6 +226,7 @@ func NewExternalMessage(svc *Service, message *protogen.Message) (*Message, erro
 		IsPrivate:  svc.IsPrivate,
 		ImportPath: string(message.GoIdent.GoImportPath),
 		Name:       message.GoIdent.GoName,
+		FullName:   string(message.Desc.FullName()),
 	}
 
 	importPath := strings.Split(msg.ImportPath, "/")
@@ -176,6 +243,10 @@ func NewExternalMessage(svc *Service, message *protogen.Message) (*Message, erro
 
 	if msg.IsLatest {
 		msg.Private, ok = svc.Private.MessageByName[messageName]
+		if !ok && isWellKnownMessage(message) {
+			msg.Private, ok = newWellKnownMessage(msg), true
+		}
+
 		if !ok {
 			return nil, NewErrMessageNotFound(messageName, svc.Private)
 		}
@@ -187,6 +258,11 @@ func NewExternalMessage(svc *Service, message *protogen.Message) (*Message, erro
 
 	// All other messages will chain to a message in the next service version.
 	msg.Next, ok = svc.Next.MessageByName[messageName]
+	if !ok && isWellKnownMessage(message) {
+		msg.Next, ok = newWellKnownMessage(msg), true
+		msg.Next.Private = newWellKnownMessage(msg)
+	}
+
 	if !ok {
 		return nil, NewErrMessageNotFound(messageName, svc.Next)
 	}
@@ -197,6 +273,31 @@ func NewExternalMessage(svc *Service, message *protogen.Message) (*Message, erro
 	return msg, nil
 }
 
+// newWellKnownMessage creates a placeholder for a well known message that is
+// not used by the next or private service. Fields of well known messages may
+// be converted to fields of other types, so the message is converted as is.
+func newWellKnownMessage(msg *Message) *Message {
+	return &Message{
+		IsExternal:  true,
+		ImportPath:  msg.ImportPath,
+		Name:        msg.Name,
+		PackageName: msg.PackageName,
+		FullName:    msg.FullName,
+	}
+}
+
+// isWellKnownMessage checks if a message is a wrapper, a timestamp, or a
+// duration.
+func isWellKnownMessage(message *protogen.Message) bool {
+	switch message.Desc.FullName() {
+	case timestampFullName, durationFullName:
+		return true
+	}
+
+	_, ok := wrapperTypes[message.Desc.FullName()]
+	return ok
+}
+
 func NewMethodExternalMessage(svc *Service, method *protogen.Method, message *protogen.Message, isInput bool) *Message {
 	msg := &Message{
 		IsExternal: true,
@@ -214,6 +315,18 @@ func NewMethodExternalMessage(svc *Service, method *protogen.Method, message *pr
 	return msg
 }
 
+// hasOneOf checks if a message has a oneof. Synthetic oneofs of proto3
+// `optional` fields are ignored.
+func hasOneOf(message *protogen.Message) bool {
+	for _, oneof := range message.Oneofs {
+		if !oneof.Desc.IsSynthetic() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func isMessageMatch(a, b *Message) bool {
 	// Messages must be in the same package and have the same name to match.
 	if a.ImportPath != b.ImportPath {
@@ -222,3 +335,72 @@ func isMessageMatch(a, b *Message) bool {
 
 	return a.Name == b.Name
 }
+
+// FieldPath maps the name of a field in the next or private message to the
+// name of the field in a public message. Field paths are used to rewrite
+// errors returned by the next or private service.
+type FieldPath struct {
+	From    string
+	To      string
+	Prefix  string
+	Message *Message
+}
+
+// FieldPaths returns the field paths of the message. When `fromNext` is true,
+// field names are read from the next message, otherwise they are read from the
+// private message. Deprecated fields are always read from the private message.
+func (m *Message) FieldPaths(fromNext bool) []FieldPath {
+	var paths []FieldPath
+	seen := make(map[string]bool)
+
+	add := func(f *Field) {
+		path := FieldPath{To: f.ProtoName}
+
+		// Composed fields are not present in the next message. Moved fields
+		// are not present in the next or private message.
+		if fromNext && !f.IsDeprecated && f.IsComposed {
+			return
+		}
+
+		if f.IsNextMoved() || f.IsPrivateMoved() {
+			return
+		}
+
+		if fromNext && !f.IsDeprecated && f.Next != nil {
+			path.From = f.Next.ProtoName
+		} else if f.Private != nil {
+			path.From = f.Private.ProtoName
+			if fromNext {
+				path.Prefix = "Deprecated"
+			}
+		} else {
+			return
+		}
+
+		if f.IsMessage && f.Message != nil && !f.Message.IsExternal {
+			path.Message = f.Message
+		}
+
+		// Fields that have an identical name and no nested message to map
+		// are left as is.
+		if seen[path.From] || (path.From == path.To && path.Message == nil) {
+			return
+		}
+
+		seen[path.From] = true
+		paths = append(paths, path)
+	}
+
+	for _, f := range m.Fields {
+		if !f.IsOneOf {
+			add(f)
+			continue
+		}
+
+		for _, member := range f.Members {
+			add(member)
+		}
+	}
+
+	return paths
+}
@@ -11,11 +11,17 @@ type Method struct {
 	IsLatest         bool
 	IsDeprecated     bool
 	IsConverterEmpty bool
+	IsHook           bool
+	IsAlias          bool
 	Name             string
+	FullName         string
+	Comments         string
 	Private          *Method
 	Next             *Method
 	Input            *Message
 	Output           *Message
+	Pagination       *Pagination
+	Alias            *Alias
 }
 
 // NewMethod creates a `Method`. An error will be returned if the method
@@ -26,7 +32,11 @@ func NewMethod(svc *Service, method *protogen.Method, input, output *Message) (*
 		IsLatest:         svc.IsLatest,
 		IsConverterEmpty: options.IsMethodConverterEmpty(method),
 		IsDeprecated:     options.IsDeprecatedMethod(method),
+		IsHook:           options.IsMethodHook(method),
+		IsAlias:          options.MethodAlias(method) != nil,
 		Name:             method.GoName,
+		FullName:         string(method.Desc.FullName()),
+		Comments:         commentText(method.Comments.Leading),
 		Input:            input,
 		Output:           output,
 	}
@@ -49,8 +59,34 @@ func NewMethod(svc *Service, method *protogen.Method, input, output *Message) (*
 	m.Input.IsConverterEmpty = m.IsConverterEmpty
 	m.Output.IsConverterEmpty = m.IsConverterEmpty
 
+	pagination, err := NewPagination(m, method)
+	if err != nil {
+		return nil, err
+	}
+
+	m.Pagination = pagination
+
+	// Alias methods call another method of the service, which is assigned
+	// once all methods are created. Their input and output messages are not
+	// converted.
+	if m.IsAlias {
+		if m.IsPrivate || m.IsHook || m.Pagination != nil || !m.Input.IsAlias || !m.Output.IsAlias {
+			return nil, NewErrInvalidAlias(m)
+		}
+
+		return m, nil
+	}
+
+	if m.Input.IsAlias || m.Output.IsAlias {
+		return nil, NewErrInvalidAlias(m)
+	}
+
 	// Private methods are the last in the service chain.
 	if m.IsPrivate {
+		if m.IsHook {
+			return nil, NewErrInvalidHook(m)
+		}
+
 		return m, nil
 	}
 
@@ -59,6 +95,12 @@ func NewMethod(svc *Service, method *protogen.Method, input, output *Message) (*
 	// Methods of the latest service or deprecated methods chain directly to the
 	// private service.
 	if m.IsLatest || m.IsDeprecated {
+		// Hooked methods call the private service through the hook rather
+		// than a private method.
+		if m.IsHook {
+			return m, checkHook(svc, m)
+		}
+
 		m.Private, ok = svc.Private.MethodByName[methodName]
 		if !ok {
 			return nil, NewErrMethodNotFound(methodName, svc.Private)
@@ -74,15 +116,24 @@ func NewMethod(svc *Service, method *protogen.Method, input, output *Message) (*
 			m.Output.IsMatch = isMessageMatch(m.Output, m.Private.Output)
 		}
 
-		return m, nil
+		return m, checkPagination(m, m.Private)
 	}
 
 	// All other methods will chain to a methods in the next service version.
+	if m.IsHook {
+		return nil, NewErrInvalidHook(m)
+	}
+
 	m.Next, ok = svc.Next.MethodByName[methodName]
 	if !ok {
 		return nil, NewErrMethodNotFound(methodName, svc.Next)
 	}
 
+	// Alias methods don't return a private output to the calling method.
+	if m.Next.IsAlias {
+		return nil, NewErrInvalidAlias(m)
+	}
+
 	m.Private = m.Next.Private
 
 	if m.Input.IsExternal {
@@ -97,5 +148,41 @@ func NewMethod(svc *Service, method *protogen.Method, input, output *Message) (*
 		m.Output.IsMatch = isMessageMatch(m.Output, m.Next.Output)
 	}
 
-	return m, nil
+	return m, checkPagination(m, m.Next)
+}
+
+// checkPagination ensures a paginated method only calls a paginated method.
+// Converting a page token to an offset requires the results of the method
+// to build the next page token.
+func checkPagination(m, next *Method) error {
+	if (m.Pagination == nil) != (next.Pagination == nil) {
+		return NewErrPaginationMismatch(m, next)
+	}
+
+	if m.Pagination == nil {
+		return nil
+	}
+
+	if m.Pagination.IsToken && next.Pagination.IsOffset && m.Pagination.Results == nil {
+		return NewErrPaginationResultsRequired(m)
+	}
+
+	return nil
+}
+
+// checkHook ensures the input and output of a hooked method are converted to
+// private messages. The private input must be the input of a private method to
+// accept the mutators of previous service versions.
+func checkHook(svc *Service, m *Method) error {
+	if m.Pagination != nil || m.Input.IsExternal || m.Output.IsExternal {
+		return NewErrInvalidHook(m)
+	}
+
+	for _, method := range svc.Private.Methods {
+		if method.Input == m.Input.Private {
+			return nil
+		}
+	}
+
+	return NewErrInvalidHook(m)
 }
@@ -16,7 +16,11 @@ func NewOneOf(svc *Service, msg *Message, oneof *protogen.Oneof) (*Field, error)
 		IsRequired:   options.IsRequiredOneOf(oneof),
 		IsDeprecated: options.IsDeprecatedOneOf(oneof),
 		Name:         oneof.GoName,
+		ProtoName:    string(oneof.Desc.Name()),
+		FullName:     string(oneof.Desc.FullName()),
+		Comments:     commentText(oneof.Comments.Leading),
 		Type:         OneOfType,
+		MemberByName: make(map[string]*Field),
 	}
 
 	// Assign the private oneof and next oneof. This is only done if the oneof
@@ -46,7 +50,20 @@ func NewOneOf(svc *Service, msg *Message, oneof *protogen.Oneof) (*Field, error)
 		}
 	}
 
-	rules, err := NewRules(f, options.OneOfValidate(oneof))
+	// Members are the fields of the oneof. They are chained to the members of
+	// the next and private oneofs to map field names between services.
+	for _, field := range oneof.Fields {
+		m, err := newOneOfMember(svc, msg, f, field)
+		if err != nil {
+			return nil, NewErrCreateField(f, msg, err)
+		}
+
+		f.Members = append(f.Members, m)
+		f.MemberByName[fieldKey(field)] = m
+	}
+
+	f.Validate = options.OneOfValidate(oneof)
+	rules, err := NewRules(f, f.Validate)
 	if err != nil {
 		return nil, NewErrCreateField(f, msg, err)
 	}
@@ -55,3 +72,44 @@ func NewOneOf(svc *Service, msg *Message, oneof *protogen.Oneof) (*Field, error)
 
 	return f, nil
 }
+
+func newOneOfMember(svc *Service, msg *Message, oneof *Field, field *protogen.Field) (*Field, error) {
+	m := &Field{
+		IsPrivate:    oneof.IsPrivate,
+		IsLatest:     oneof.IsLatest,
+		IsDeprecated: oneof.IsDeprecated,
+		IsMessage:    true,
+		Name:         field.GoName,
+		ProtoName:    string(field.Desc.Name()),
+		JSONName:     field.Desc.JSONName(),
+		FullName:     string(field.Desc.FullName()),
+		Number:       field.Desc.Number(),
+		Comments:     commentText(field.Comments.Leading),
+		Type:         MessageType,
+		Message:      svc.MessageByName[messageKey(field.Message)],
+	}
+
+	if m.IsPrivate {
+		return m, nil
+	}
+
+	fieldName := options.FieldName(field)
+	var ok bool
+
+	if oneof.Next != nil {
+		m.Next, ok = oneof.Next.MemberByName[fieldName]
+		if !ok {
+			return nil, NewErrFieldNotFound(fieldName, msg.Next)
+		}
+
+		m.Private = m.Next.Private
+		return m, nil
+	}
+
+	m.Private, ok = oneof.Private.MemberByName[fieldName]
+	if !ok {
+		return nil, NewErrFieldNotFound(fieldName, msg.Private)
+	}
+
+	return m, nil
+}
@@ -27,3 +27,19 @@ func ReceiveEnumValueNames(value *protogen.EnumValue) []string {
 
 	return []string{EnumValueName(value)}
 }
+
+// ReceiveEnumValueName returns the `name` of the receive annotation. Only the
+// `names` of the annotation are received, so it is reported by linting.
+func ReceiveEnumValueName(value *protogen.EnumValue) string {
+	options := value.Desc.Options().(*descriptorpb.EnumValueOptions)
+	annotation := proto.GetExtension(options, svc.E_EnumValue).(*svc.EnumValueAnnotation)
+	return annotation.GetReceive().GetName()
+}
+
+// ReceiveEnumValueNamesSet returns the `names` of the receive annotation,
+// without defaulting to the name of the enum value.
+func ReceiveEnumValueNamesSet(value *protogen.EnumValue) []string {
+	options := value.Desc.Options().(*descriptorpb.EnumValueOptions)
+	annotation := proto.GetExtension(options, svc.E_EnumValue).(*svc.EnumValueAnnotation)
+	return annotation.GetReceive().GetNames()
+}
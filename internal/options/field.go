@@ -30,6 +30,12 @@ func IsDeprecatedField(field *protogen.Field) bool {
 	return annotation.GetDeprecated()
 }
 
+func FieldConvert(field *protogen.Field) *svc.Convert {
+	options := field.Desc.Options().(*descriptorpb.FieldOptions)
+	annotation := proto.GetExtension(options, svc.E_Field).(*svc.FieldAnnotation)
+	return annotation.GetConvert()
+}
+
 func IsRequiredField(field *protogen.Field) bool {
 	options := field.Desc.Options().(*descriptorpb.FieldOptions)
 	annotation := proto.GetExtension(options, svc.E_Field).(*svc.FieldAnnotation)
@@ -12,3 +12,13 @@ func GoPackage(file *protogen.File) string {
 	options := file.Desc.Options().(*descriptorpb.FileOptions)
 	return proto.GetExtension(options, svc.E_GoPackage).(string)
 }
+
+func PrivatePackage(file *protogen.File) string {
+	options := file.Desc.Options().(*descriptorpb.FileOptions)
+	return proto.GetExtension(options, svc.E_PrivatePackage).(string)
+}
+
+func NextPackage(file *protogen.File) string {
+	options := file.Desc.Options().(*descriptorpb.FileOptions)
+	return proto.GetExtension(options, svc.E_NextPackage).(string)
+}
@@ -29,3 +29,9 @@ func IsConverterEmpty(message *protogen.Message) bool {
 	annotation := proto.GetExtension(options, svc.E_Message).(*svc.MessageAnnotation)
 	return annotation.GetConverter().GetEmpty()
 }
+
+func MessageCompose(message *protogen.Message) []*svc.Compose {
+	options := message.Desc.Options().(*descriptorpb.MessageOptions)
+	annotation := proto.GetExtension(options, svc.E_Message).(*svc.MessageAnnotation)
+	return annotation.GetCompose()
+}
@@ -29,3 +29,21 @@ func IsMethodConverterEmpty(method *protogen.Method) bool {
 	annotation := proto.GetExtension(options, svc.E_Method).(*svc.MethodAnnotation)
 	return annotation.GetConverter().GetEmpty()
 }
+
+func MethodPagination(method *protogen.Method) *svc.Pagination {
+	options := method.Desc.Options().(*descriptorpb.MethodOptions)
+	annotation := proto.GetExtension(options, svc.E_Method).(*svc.MethodAnnotation)
+	return annotation.GetPagination()
+}
+
+func IsMethodHook(method *protogen.Method) bool {
+	options := method.Desc.Options().(*descriptorpb.MethodOptions)
+	annotation := proto.GetExtension(options, svc.E_Method).(*svc.MethodAnnotation)
+	return annotation.GetHook()
+}
+
+func MethodAlias(method *protogen.Method) *svc.Alias {
+	options := method.Desc.Options().(*descriptorpb.MethodOptions)
+	annotation := proto.GetExtension(options, svc.E_Method).(*svc.MethodAnnotation)
+	return annotation.GetAlias()
+}
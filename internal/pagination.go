@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+
+	"github.com/dane/protoc-gen-go-svc/gen/svc"
+	"github.com/dane/protoc-gen-go-svc/internal/options"
+)
+
+const (
+	pageSizeFieldName      = "page_size"
+	pageTokenFieldName     = "page_token"
+	nextPageTokenFieldName = "next_page_token"
+	offsetFieldName        = "offset"
+	limitFieldName         = "limit"
+)
+
+type Pagination struct {
+	IsToken       bool
+	IsOffset      bool
+	MaxPageSize   int32
+	PageSize      *Field
+	PageToken     *Field
+	NextPageToken *Field
+	Offset        *Field
+	Limit         *Field
+	Results       *Field
+}
+
+// NewPagination creates a `Pagination` from the fields of the method input and
+// output messages. Nil is returned if the method is not paginated. An error
+// will be returned if the pagination fields are missing or of the wrong type.
+func NewPagination(m *Method, method *protogen.Method) (*Pagination, error) {
+	pagination := options.MethodPagination(method)
+	if pagination == nil {
+		return nil, nil
+	}
+
+	p := &Pagination{
+		MaxPageSize: pagination.GetMaxPageSize(),
+	}
+
+	var err error
+	var size *Field
+
+	switch pagination.GetStyle() {
+	case svc.Pagination_TOKEN:
+		p.IsToken = true
+
+		if p.PageSize, err = paginationField(m.Input, pageSizeFieldName, isIntegerType); err != nil {
+			return nil, err
+		}
+
+		if p.PageToken, err = paginationField(m.Input, pageTokenFieldName, isStringType); err != nil {
+			return nil, err
+		}
+
+		if p.NextPageToken, err = paginationField(m.Output, nextPageTokenFieldName, isStringType); err != nil {
+			return nil, err
+		}
+
+		size = p.PageSize
+	case svc.Pagination_OFFSET:
+		p.IsOffset = true
+
+		if p.Offset, err = paginationField(m.Input, offsetFieldName, isIntegerType); err != nil {
+			return nil, err
+		}
+
+		if p.Limit, err = paginationField(m.Input, limitFieldName, isIntegerType); err != nil {
+			return nil, err
+		}
+
+		size = p.Limit
+	default:
+		return nil, NewErrInvalidPaginationStyle(m)
+	}
+
+	if name := pagination.GetResults(); name != "" {
+		if p.Results, err = paginationField(m.Output, name, isRepeated); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.MaxPageSize > 0 {
+		size.Rules = append(size.Rules, fmt.Sprintf("validation.Max(%d)", p.MaxPageSize))
+	}
+
+	return p, nil
+}
+
+// paginationFieldNames returns the names of the input and output fields used by
+// a pagination style.
+func paginationFieldNames(style svc.Pagination_Style) ([]string, []string) {
+	switch style {
+	case svc.Pagination_TOKEN:
+		return []string{pageSizeFieldName, pageTokenFieldName}, []string{nextPageTokenFieldName}
+	case svc.Pagination_OFFSET:
+		return []string{offsetFieldName, limitFieldName}, nil
+	}
+
+	return nil, nil
+}
+
+func paginationField(msg *Message, name string, valid func(*Field) bool) (*Field, error) {
+	f, ok := msg.FieldByName[name]
+	if !ok {
+		return nil, NewErrFieldNotFound(name, msg)
+	}
+
+	if !valid(f) {
+		return nil, NewErrInvalidPaginationField(f, msg)
+	}
+
+	return f, nil
+}
+
+func isIntegerType(f *Field) bool {
+	if f.IsRepeated {
+		return false
+	}
+
+	switch f.Type {
+	case Int32Type, Int64Type, Uint64Type:
+		return true
+	}
+
+	return false
+}
+
+func isStringType(f *Field) bool {
+	return !f.IsRepeated && f.Type == StringType
+}
+
+func isRepeated(f *Field) bool {
+	return f.IsRepeated
+}
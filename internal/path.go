@@ -0,0 +1,194 @@
+package internal
+
+import (
+	"strings"
+)
+
+// parentPathSegment is the leading segment of a field path that targets a
+// field of the parent message.
+const parentPathSegment = "^"
+
+// FieldMove moves the value of a field between a path of public fields and a
+// path of fields of the next or private message. Both paths end with the field
+// holding the value.
+type FieldMove struct {
+	From []*Field
+	To   []*Field
+}
+
+// IsDeprecated checks if a field of the public path is deprecated. Deprecated
+// fields are read from the private message.
+func (m FieldMove) IsDeprecated() bool {
+	for _, f := range m.From {
+		if f.IsDeprecated {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsNextMoved checks if the field is written to and read from a field of a
+// nested or parent message of the next service.
+func (f *Field) IsNextMoved() bool {
+	return len(f.NextPath) > 0 || f.IsNextParentPath
+}
+
+// IsPrivateMoved checks if the field is written to and read from a field of a
+// nested or parent message of the private service.
+func (f *Field) IsPrivateMoved() bool {
+	return len(f.PrivatePath) > 0 || f.IsPrivateParentPath
+}
+
+// NextMoves returns the moves of fields of the message, and of fields of its
+// nested messages that target the message, to the next message.
+func (m *Message) NextMoves() []FieldMove {
+	return m.moves(func(f *Field) ([]*Field, bool) {
+		if f.IsDeprecated || f.Next == nil || !f.IsNextMoved() {
+			return nil, false
+		}
+
+		return append(append([]*Field{}, f.NextPath...), f.Next), f.IsNextParentPath
+	})
+}
+
+// PrivateMoves returns the moves of fields of the message, and of fields of its
+// nested messages that target the message, to the private message.
+func (m *Message) PrivateMoves() []FieldMove {
+	return m.moves(func(f *Field) ([]*Field, bool) {
+		if f.Private == nil || !f.IsPrivateMoved() {
+			return nil, false
+		}
+
+		return append(append([]*Field{}, f.PrivatePath...), f.Private), f.IsPrivateParentPath
+	})
+}
+
+func (m *Message) moves(target func(*Field) ([]*Field, bool)) []FieldMove {
+	var moves []FieldMove
+
+	for _, f := range m.ConvertedFields() {
+		if to, parent := target(f); to != nil && !parent {
+			moves = append(moves, FieldMove{From: []*Field{f}, To: to})
+		}
+	}
+
+	// Fields of nested messages that target the parent message are moved by
+	// the parent message, which holds both the nested and the target message.
+	for _, f := range m.ConvertedFields() {
+		if !f.IsMessage || f.IsRepeated || f.Message.Parent != m {
+			continue
+		}
+
+		for _, nested := range f.Message.ConvertedFields() {
+			if to, parent := target(nested); to != nil && parent {
+				moves = append(moves, FieldMove{From: []*Field{f, nested}, To: to})
+			}
+		}
+	}
+
+	return moves
+}
+
+// isFieldPath checks if a delegate name is a path to a field of a nested or
+// parent message, such as `address.city` or `^.city`.
+func isFieldPath(name string) bool {
+	return strings.Contains(name, ".")
+}
+
+// assignFieldPaths assigns the next and private fields of a field delegated to
+// a field path. An error will be returned if the path does not resolve to a
+// field of the same type.
+func assignFieldPaths(f *Field, msg *Message, path string) error {
+	if f.IsComposed || f.IsPointer() {
+		return NewErrInvalidFieldPath(path, msg)
+	}
+
+	var err error
+
+	// Latest and deprecated fields chain directly to the private service.
+	if f.IsLatest || f.IsDeprecated || msg.IsDeprecated {
+		f.PrivatePath, f.Private, f.IsPrivateParentPath, err = resolveFieldPath(msg.Private, path)
+		if err != nil {
+			return err
+		}
+
+		if !isPathMatch(f, f.Private) {
+			return NewErrInvalidFieldPath(path, msg.Private)
+		}
+
+		return nil
+	}
+
+	f.NextPath, f.Next, f.IsNextParentPath, err = resolveFieldPath(msg.Next, path)
+	if err != nil {
+		return err
+	}
+
+	if !isPathMatch(f, f.Next) {
+		return NewErrInvalidFieldPath(path, msg.Next)
+	}
+
+	// The private field is found through the next field. The path of a next
+	// field that is moved again within the private service is appended.
+	if f.Next.IsPrivateParentPath && f.IsNextMoved() {
+		return NewErrInvalidFieldPath(path, msg.Next)
+	}
+
+	for _, p := range f.NextPath {
+		if p.Private == nil || p.IsPrivateMoved() {
+			return NewErrInvalidFieldPath(path, msg.Next)
+		}
+
+		f.PrivatePath = append(f.PrivatePath, p.Private)
+	}
+
+	f.PrivatePath = append(f.PrivatePath, f.Next.PrivatePath...)
+	f.IsPrivateParentPath = f.IsNextParentPath || f.Next.IsPrivateParentPath
+	f.Private = f.Next.Private
+
+	return nil
+}
+
+// resolveFieldPath finds the field of a path starting at a message. The
+// message fields leading to the field are returned with it.
+func resolveFieldPath(msg *Message, path string) ([]*Field, *Field, bool, error) {
+	segments := strings.Split(path, ".")
+
+	parent := segments[0] == parentPathSegment
+	if parent {
+		if msg.Parent == nil {
+			return nil, nil, false, NewErrInvalidFieldPath(path, msg)
+		}
+
+		segments = segments[1:]
+		msg = msg.Parent
+	}
+
+	var fields []*Field
+	for i, segment := range segments {
+		f, ok := msg.FieldByName[segment]
+		if !ok {
+			return nil, nil, false, NewErrFieldNotFound(segment, msg)
+		}
+
+		if i == len(segments)-1 {
+			return fields, f, parent, nil
+		}
+
+		if !f.IsMessage || f.IsRepeated || f.Message.IsExternal || f.IsNextMoved() || f.IsPrivateMoved() {
+			return nil, nil, false, NewErrInvalidFieldPath(path, msg)
+		}
+
+		fields = append(fields, f)
+		msg = f.Message
+	}
+
+	return nil, nil, false, NewErrInvalidFieldPath(path, msg)
+}
+
+// isPathMatch checks if the value of a field can be assigned to the field of a
+// path as is.
+func isPathMatch(a, b *Field) bool {
+	return isMatch(a, b) && a.IsRepeated == b.IsRepeated && !b.IsPointer()
+}
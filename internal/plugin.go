@@ -5,41 +5,109 @@ import (
 	"fmt"
 	"os"
 	"path"
-	"sort"
 	"strings"
 
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/pluginpb"
 
 	"github.com/dane/protoc-gen-go-svc/internal/options"
 )
 
 const (
-	FileName = "service.pb.go"
+	FileName                  = "service.pb.go"
+	MigrationMarkdownFileName = "migration.md"
+	MigrationDOTFileName      = "migration.dot"
+	IRFileName                = "ir.json"
+	ConvertersJSFileName      = "converters.js"
+	ConvertersTSFileName      = "converters.d.ts"
+	MessagesTSFileName        = "messages.d.ts"
+)
+
+// Modes of the `field_numbers` parameter.
+const (
+	FieldNumbersError  = "error"
+	FieldNumbersWarn   = "warn"
+	FieldNumbersIgnore = "ignore"
 )
 
 type Plugin struct {
 	Verbose            bool
 	PrivatePackageName string
+
+	// Docs enables writing a migration map of each chain in Markdown, with a
+	// Mermaid diagram, and in DOT.
+	Docs bool
+
+	// IR enables writing the intermediate representation of each chain in
+	// JSON format.
+	IR bool
+
+	// JavaScript enables writing JavaScript converters, with TypeScript
+	// declarations, of the JSON representation of the messages of each
+	// service version.
+	JavaScript bool
+
+	// Lint enables building each chain and reporting suspicious annotations
+	// without writing any files.
+	Lint bool
+
+	// FieldNumbers sets whether field numbers reserved, or reused by a
+	// different field, across versions fail generation, are written to stderr
+	// as warnings or are ignored. Warnings are written when it is not set.
+	FieldNumbers string
+
+	// TemplatesDir is a directory of template files overriding or extending
+	// the built-in templates.
+	TemplatesDir string
+
+	// Chain is a semicolon separated list of the public packages from the oldest
+	// service version to the latest. Packages are sorted by version when it is
+	// not set.
+	Chain string
+
+	// FieldPathKeys is a semicolon separated list of the `ErrorInfo` metadata
+	// keys whose values are field paths rewritten for the public services.
+	FieldPathKeys string
+
+	// overrides are the templates read from the directory of the `templates`
+	// plugin parameter. They are parsed after `Partials`, so they can replace
+	// the built-in templates and define the extension points.
+	overrides []templateFile
 }
 
 type Package struct {
-	ProtoName         protoreflect.FullName
-	Name              protogen.GoPackageName
-	ImportPath        protogen.GoImportPath
-	ServiceImportPath protogen.GoImportPath
-	Service           *protogen.Service
-	Messages          []*protogen.Message
+	ProtoName          protoreflect.FullName
+	Name               protogen.GoPackageName
+	ImportPath         protogen.GoImportPath
+	ServiceImportPath  protogen.GoImportPath
+	ServicePackageName string
+	PrivatePackageName protoreflect.FullName
+	NextPackageName    protoreflect.FullName
+	Private            *Package
+	Next               *Package
+	Service            *protogen.Service
+	Messages           []*protogen.Message
 }
 
 func (p *Plugin) Run(plugin *protogen.Plugin) error {
+	plugin.SupportedFeatures = uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
 	privatePackageName := protoreflect.FullName(p.PrivatePackageName)
 
-	var (
-		servicePackageName string
-		serviceImportPath  string
-		goPackage          string
-	)
+	switch p.FieldNumbers {
+	case "", FieldNumbersError, FieldNumbersWarn, FieldNumbersIgnore:
+	default:
+		return NewErrInvalidFieldNumbers(p.FieldNumbers)
+	}
+
+	if p.TemplatesDir != "" {
+		files, err := readTemplates(p.TemplatesDir)
+		if err != nil {
+			return err
+		}
+
+		p.overrides = files
+	}
 
 	// Group service, package name, and import path as a Package. Grouping is
 	// managed with a map for easy lookups later on.
@@ -59,27 +127,44 @@ func (p *Plugin) Run(plugin *protogen.Plugin) error {
 				ImportPath: file.GoImportPath,
 			}
 
-			if value := options.GoPackage(file); value != "" {
-				if goPackage == "" {
-					goPackage = value
-				}
+			packages[key] = pkg
+		}
 
-				if goPackage != value {
-					return NewErrBadServiceImportPath(file, goPackage, value)
-				}
+		// All files of a package must be generated to the same location.
+		if value := options.GoPackage(file); value != "" {
+			opt := strings.SplitN(value, ";", 2)
+			if len(opt) != 2 {
+				return NewErrInvalidServiceImportPath(file, value)
+			}
 
-				opt := strings.SplitN(value, ";", 2)
-				if len(opt) != 2 {
-					return NewErrInvalidServiceImportPath(file, value)
-				}
+			if pkg.ServiceImportPath == "" {
+				pkg.ServiceImportPath = protogen.GoImportPath(opt[0])
+				pkg.ServicePackageName = opt[1]
+			}
+
+			if goPackage := string(pkg.ServiceImportPath) + ";" + pkg.ServicePackageName; goPackage != value {
+				return NewErrBadServiceImportPath(file, goPackage, value)
+			}
+		}
 
-				serviceImportPath = opt[0]
-				servicePackageName = opt[1]
+		if value := protoreflect.FullName(options.PrivatePackage(file)); value != "" {
+			if pkg.PrivatePackageName == "" {
+				pkg.PrivatePackageName = value
+			}
 
-				pkg.ServiceImportPath = protogen.GoImportPath(serviceImportPath)
+			if pkg.PrivatePackageName != value {
+				return NewErrBadPrivatePackage(file, string(pkg.PrivatePackageName), string(value))
 			}
+		}
 
-			packages[key] = pkg
+		if value := protoreflect.FullName(options.NextPackage(file)); value != "" {
+			if pkg.NextPackageName == "" {
+				pkg.NextPackageName = value
+			}
+
+			if pkg.NextPackageName != value {
+				return NewErrBadNextPackage(file, string(pkg.NextPackageName), string(value))
+			}
 		}
 
 		// Assign the service to the package if it is defined in this file.
@@ -94,33 +179,33 @@ func (p *Plugin) Run(plugin *protogen.Plugin) error {
 		pkg.Messages = append(pkg.Messages, file.Messages...)
 	}
 
-	// Ensure a private service is present.
-	privatePackage, ok := packages[privatePackageName]
-	if !ok {
-		return NewErrPrivatePackageNotFound(privatePackageName)
+	// Group packages into independent chains by their service location. Each
+	// chain has private packages and public packages sorted in descending
+	// order.
+	chains, err := buildChains(packages, privatePackageName, parseList(p.Chain))
+	if err != nil {
+		return err
 	}
 
-	// Convert map of packages to sorted slice. Exclude the private package from
-	// the slice, sort, and prepend it after. This is to ensure the private
-	// package is always first in the slice.
-	var allPackages []*Package
-	for _, pkg := range packages {
-		if pkg == privatePackage {
-			continue
+	for _, chain := range chains {
+		if err := p.generate(plugin, chain); err != nil {
+			return err
 		}
-
-		allPackages = append(allPackages, pkg)
 	}
 
-	sort.Slice(allPackages, func(a, b int) bool {
-		return allPackages[a].ProtoName > allPackages[b].ProtoName
-	})
+	return nil
+}
 
-	allPackages = append([]*Package{privatePackage}, allPackages...)
+// generate writes the services of a chain and the register file of the chain.
+func (p *Plugin) generate(plugin *protogen.Plugin, chain *Chain) error {
+	servicePackageName := chain.PackageName
+	serviceImportPath := chain.ImportPath
+	allPackages := append(append([]*Package{}, chain.Privates...), chain.Packages...)
 
-	// Create services in order of private service then public services in
-	// decending order.
+	// Create services in order of private services then public services in
+	// decending order. Each public service follows its next service.
 	var svcChain []*Service
+	servicesByPackage := make(map[*Package]*Service)
 
 	if p.Verbose {
 		defer func() {
@@ -140,50 +225,146 @@ func (p *Plugin) Run(plugin *protogen.Plugin) error {
 	}
 
 	for _, pkg := range allPackages {
+		// Public services chain to their private service and their next
+		// service. The latest services only chain to their private service.
+		var serviceChain []*Service
+		if pkg.Private != nil {
+			serviceChain = append(serviceChain, servicesByPackage[pkg.Private])
+			if pkg.Next != nil {
+				serviceChain = append(serviceChain, servicesByPackage[pkg.Next])
+			}
+		}
+
 		svc, err := NewService(
 			pkg.ProtoName,
 			pkg.Name,
 			pkg.ImportPath,
-			pkg.ServiceImportPath,
+			protogen.GoImportPath(serviceImportPath),
 			pkg.Service,
 			pkg.Messages,
-			svcChain,
+			serviceChain,
 		)
 
 		if err != nil {
 			return err
 		}
 
+		svc.FieldPathKeys = parseList(p.FieldPathKeys)
 		svcChain = append(svcChain, svc)
+		servicesByPackage[pkg] = svc
+		if !svc.IsPrivate {
+			svc.RegisterPrivates = svcChain[:len(chain.Privates)]
+		}
+
+		if p.Lint {
+			continue
+		}
 
 		// Write service file.
 		importPath := protogen.GoImportPath(path.Join(servicePackageName, svc.PackageName))
 		fileName := path.Join(servicePackageName, svc.PackageName, FileName)
 
 		file := plugin.NewGeneratedFile(fileName, importPath)
-		if err := render(file, svc.ProtoPackageName, serviceTemplate, svc); err != nil {
+		if err := p.render(file, svc.ProtoPackageName, serviceTemplate, svc); err != nil {
 			return err
 		}
 
-		// Write testing service file.
+		// Write JavaScript converters and TypeScript declarations. Message
+		// interfaces are written for private services too, since converters
+		// of public services refer to them.
+		if p.JavaScript {
+			file = plugin.NewGeneratedFile(path.Join(servicePackageName, svc.PackageName, MessagesTSFileName), importPath)
+			if err := p.renderDoc(file, "messages-ts", messagesTSTemplate, svc); err != nil {
+				return err
+			}
+
+			if !svc.IsPrivate {
+				file = plugin.NewGeneratedFile(path.Join(servicePackageName, svc.PackageName, ConvertersJSFileName), importPath)
+				if err := p.renderDoc(file, "converters-js", convertersJSTemplate, svc); err != nil {
+					return err
+				}
+
+				file = plugin.NewGeneratedFile(path.Join(servicePackageName, svc.PackageName, ConvertersTSFileName), importPath)
+				if err := p.renderDoc(file, "converters-ts", convertersTSTemplate, svc); err != nil {
+					return err
+				}
+			}
+		}
+
+		// Write testing service file. Private services are faked.
 		importPath = protogen.GoImportPath(path.Join(servicePackageName, svc.PackageName, "testing"))
 		fileName = path.Join(servicePackageName, svc.PackageName, "testing", FileName)
 
-		if !svc.IsPrivate {
-			file = plugin.NewGeneratedFile(fileName, importPath)
-			if err := render(file, "testing", testingTemplate, svc); err != nil {
+		file = plugin.NewGeneratedFile(fileName, importPath)
+		if svc.IsPrivate {
+			if err := p.render(file, "fake", fakeTemplate, svc); err != nil {
+				return err
+			}
+		} else {
+			if err := p.render(file, "testing", testingTemplate, svc); err != nil {
 				return err
 			}
 		}
 	}
 
-	// Write services register wrapper file.
-	importPath := protogen.GoImportPath(serviceImportPath)
-	fileName := path.Join(servicePackageName, FileName)
-	file := plugin.NewGeneratedFile(fileName, importPath)
-	return render(file, "register", registerTemplate, RegisterService{
+	register := RegisterService{
 		PackageName: servicePackageName,
-		Private:     svcChain[0],
-		Services:    svcChain[1:],
-	})
+		Privates:    svcChain[:len(chain.Privates)],
+		Services:    svcChain[len(chain.Privates):],
+	}
+
+	if err := checkFieldNumbers(os.Stderr, p.FieldNumbers, register); err != nil {
+		return err
+	}
+
+	if p.Lint {
+		if issues := Lint(register); len(issues) > 0 {
+			return NewErrLint(servicePackageName, issues)
+		}
+
+		return nil
+	}
+
+	// Write migration map files.
+	if p.Docs {
+		importPath := protogen.GoImportPath(serviceImportPath)
+		file := plugin.NewGeneratedFile(path.Join(servicePackageName, MigrationMarkdownFileName), importPath)
+		if err := p.renderDoc(file, "migration-markdown", migrationMarkdownTemplate, register); err != nil {
+			return err
+		}
+
+		file = plugin.NewGeneratedFile(path.Join(servicePackageName, MigrationDOTFileName), importPath)
+		if err := p.renderDoc(file, "migration-dot", migrationDOTTemplate, register); err != nil {
+			return err
+		}
+	}
+
+	// Write intermediate representation file.
+	if p.IR {
+		ir, err := NewIR(serviceImportPath, register)
+		if err != nil {
+			return err
+		}
+
+		file := plugin.NewGeneratedFile(path.Join(servicePackageName, IRFileName), protogen.GoImportPath(serviceImportPath))
+		enc := json.NewEncoder(file)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(ir); err != nil {
+			return err
+		}
+	}
+
+	// Write testing runner file of all service versions.
+	importPath := protogen.GoImportPath(path.Join(serviceImportPath, "testing"))
+	fileName := path.Join(servicePackageName, "testing", FileName)
+	file := plugin.NewGeneratedFile(fileName, importPath)
+	if err := p.render(file, "runner", runnerTemplate, register); err != nil {
+		return err
+	}
+
+	// Write services register wrapper file.
+	importPath = protogen.GoImportPath(serviceImportPath)
+	fileName = path.Join(servicePackageName, FileName)
+	file = plugin.NewGeneratedFile(fileName, importPath)
+	return p.render(file, "register", registerTemplate, register)
 }
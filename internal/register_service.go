@@ -1,7 +1,31 @@
 package internal
 
+import "strings"
+
 type RegisterService struct {
 	PackageName string
 	Services    []*Service
-	Private     *Service
+	Privates    []*Service
+}
+
+// ImplName returns the name of the RegisterServer parameter accepting the
+// implementation of a private service. The name is suffixed by the package of
+// the private service when there are multiple private services.
+func (r RegisterService) ImplName(private *Service) string {
+	return "impl" + r.privateSuffix(private)
+}
+
+// VarName returns the name of the RegisterServer variable of a private
+// service.
+func (r RegisterService) VarName(private *Service) string {
+	return "servicePrivate" + r.privateSuffix(private)
+}
+
+func (r RegisterService) privateSuffix(private *Service) string {
+	if len(r.Privates) < 2 {
+		return ""
+	}
+
+	name := private.PackageName
+	return strings.ToUpper(name[:1]) + name[1:]
 }
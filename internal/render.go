@@ -8,38 +8,80 @@ import (
 	"text/template"
 )
 
-func render(file io.Writer, name, tmpl string, data interface{}) error {
+func (p *Plugin) render(file io.Writer, name, tmpl string, data interface{}) error {
+	tpl, err := p.parse(name, tmpl)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		return err
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(formatted)
+	return err
+}
+
+// renderDoc renders a template that is not Go source, so it is written
+// without formatting.
+func (p *Plugin) renderDoc(file io.Writer, name, tmpl string, data interface{}) error {
+	tpl, err := p.parse(name, tmpl)
+	if err != nil {
+		return err
+	}
+
+	return tpl.Execute(file, data)
+}
+
+func (p *Plugin) parse(name, tmpl string) (*template.Template, error) {
 	funcs := template.FuncMap{
 		"public_from_private_config":            newPublicFromPrivateConfig(""),
 		"deprecated_public_from_private_config": newPublicFromPrivateConfig("Deprecated"),
-		"partial":                               partial,
+		"public_field_path_config":              newPublicFieldPathConfig(""),
+		"deprecated_public_field_path_config":   newPublicFieldPathConfig("Deprecated"),
+		"page_config":                           newPageConfig,
+		"presence_config":                       newPresenceConfig,
+		"convert_config":                        newConvertConfig,
+		"required_config":                       newRequiredConfig,
+		"move_config":                           newMoveConfig,
+		"path_of":                               pathOf,
+		"partial":                               p.partial,
 		"type_of":                               typeOf,
+		"comment":                               comment,
+		"node_id":                               nodeID,
+		"js_string":                             jsString,
+		"js_path":                               jsPath,
+		"js_keys":                               jsKeys,
+		"js_comment":                            jsComment,
 	}
 
 	tpl, err := template.New(name).Funcs(funcs).Parse(tmpl)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for _, partial := range Partials {
 		tpl, err = tpl.Parse(partial)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	var buf bytes.Buffer
-	if err := tpl.Execute(&buf, data); err != nil {
-		return err
-	}
-
-	formatted, err := format.Source(buf.Bytes())
-	if err != nil {
-		return err
+	// Overrides are parsed as named templates, so text outside of their
+	// definitions does not replace the root template.
+	for _, file := range p.overrides {
+		if _, err := tpl.New(file.Name).Parse(file.Content); err != nil {
+			return nil, NewErrParseTemplate(file.Name, err)
+		}
 	}
 
-	_, err = file.Write(formatted)
-	return err
+	return tpl, nil
 }
 
 type PartialNamer interface {
@@ -66,7 +108,162 @@ func newPublicFromPrivateConfig(prefix string) func(*Message) publicFromPrivateC
 	}
 }
 
-func partial(data interface{}) (string, error) {
+type publicFieldPathConfig struct {
+	*Message
+	Prefix      string
+	FromNext    bool
+	partialName string
+}
+
+func (p publicFieldPathConfig) PartialName() string {
+	return p.partialName
+}
+
+func newPublicFieldPathConfig(prefix string) func(*Message) publicFieldPathConfig {
+	return func(msg *Message) publicFieldPathConfig {
+		return publicFieldPathConfig{
+			partialName: "to-public-field-path",
+			Message:     msg,
+			Prefix:      prefix,
+			// Deprecated conversions and conversions of the latest service
+			// always read from the private service.
+			FromNext: prefix == "" && !msg.IsLatest,
+		}
+	}
+}
+
+type pageConfig struct {
+	From *Pagination
+	To   *Pagination
+	In   string
+	Out  string
+}
+
+// newPageConfig describes the pagination of a method and the method it calls.
+// `In` and `Out` are the names of the variables holding the input and output
+// of the called method.
+func newPageConfig(m *Method) pageConfig {
+	if m.Next != nil {
+		return pageConfig{
+			From: m.Pagination,
+			To:   m.Next.Pagination,
+			In:   "inNext",
+			Out:  "outNext",
+		}
+	}
+
+	return pageConfig{
+		From: m.Pagination,
+		To:   m.Private.Pagination,
+		In:   "inPriv",
+		Out:  "outPriv",
+	}
+}
+
+type presenceConfig struct {
+	To   *Field
+	From *Field
+	Dst  string
+	Src  string
+}
+
+// newPresenceConfig describes the conversion of the `from` field of `src` to
+// the `to` field of `dst` when the fields track presence differently, or are
+// enums.
+func newPresenceConfig(to, from *Field, dst, src string) presenceConfig {
+	return presenceConfig{
+		To:   to,
+		From: from,
+		Dst:  dst,
+		Src:  src,
+	}
+}
+
+type convertConfig struct {
+	*Conversion
+	Name string
+	To   *Field
+	From *Field
+	Dst  string
+	Src  string
+}
+
+// newConvertConfig describes the conversion of the `from` field of `src` to
+// the `to` field of `dst` when the field types differ. The `direction` names
+// the user defined conversion, such as "ToNext" or "FromPrivate".
+func newConvertConfig(conversion *Conversion, direction string, to, from *Field, dst, src string) convertConfig {
+	return convertConfig{
+		Conversion: conversion,
+		Name:       conversion.Func + direction,
+		To:         to,
+		From:       from,
+		Dst:        dst,
+		Src:        src,
+	}
+}
+
+// IsUnsigned checks if the integer field of the conversion is unsigned.
+func (c convertConfig) IsUnsigned() bool {
+	return c.To.Type == Uint64Type || c.From.Type == Uint64Type
+}
+
+// BitSize returns the size of the integer field of the conversion.
+func (c convertConfig) BitSize() int {
+	if c.To.Type == Int32Type || c.From.Type == Int32Type {
+		return 32
+	}
+
+	return 64
+}
+
+type moveConfig struct {
+	Value   string
+	Dst     []*Field
+	Field   *Field
+	Package string
+}
+
+// newMoveConfig describes the move of the value of the `from` path of `src` to
+// the `to` path of `out`. Messages of the `to` path are created in `pkg` when
+// they are not set.
+func newMoveConfig(src string, from, to []*Field, pkg string) moveConfig {
+	return moveConfig{
+		Value:   pathOf(src, from),
+		Dst:     to[:len(to)-1],
+		Field:   to[len(to)-1],
+		Package: pkg,
+	}
+}
+
+// pathOf returns the expression reading the last field of a path of `src`.
+// Getters are used to read through unset messages.
+func pathOf(src string, fields []*Field) string {
+	for _, f := range fields {
+		src = fmt.Sprintf("%s.Get%s()", src, f.Name)
+	}
+
+	return src
+}
+
+type requiredConfig struct {
+	Name     string
+	JSONName string
+	Field    *Field
+	Src      string
+}
+
+// newRequiredConfig describes the required check of the `field` of `src`.
+// Errors are keyed by the name of the public field `f`.
+func newRequiredConfig(f, field *Field, src string) requiredConfig {
+	return requiredConfig{
+		Name:     f.Name,
+		JSONName: f.JSONName,
+		Field:    field,
+		Src:      src,
+	}
+}
+
+func (p *Plugin) partial(data interface{}) (string, error) {
 	var name string
 	if v, ok := data.(PartialNamer); ok {
 		name = v.PartialName()
@@ -74,7 +271,7 @@ func partial(data interface{}) (string, error) {
 
 	tmpl := fmt.Sprintf(`{{ template %q . }}`, name)
 	var buf bytes.Buffer
-	if err := render(&buf, "partial", tmpl, data); err != nil {
+	if err := p.render(&buf, "partial", tmpl, data); err != nil {
 		return "", err
 	}
 
@@ -82,9 +279,19 @@ func partial(data interface{}) (string, error) {
 }
 
 func typeOf(f *Field) string {
+	if f.IsPointer() {
+		return "*" + scalarTypeOf(f)
+	}
+
+	return scalarTypeOf(f)
+}
+
+func scalarTypeOf(f *Field) string {
 	switch f.Type {
 	case StringType:
 		return "string"
+	case Int32Type:
+		return "int32"
 	case Int64Type:
 		return "int64"
 	case Uint64Type:
@@ -96,10 +303,10 @@ func typeOf(f *Field) string {
 	case BytesType:
 		return "[]byte"
 	case MessageType:
-		if f.Message.IsPrivate {
-			return fmt.Sprintf("*privatepb.%s", f.Message.Name)
-		} else if f.Message.IsExternal {
+		if f.Message.IsExternal {
 			return fmt.Sprintf("*%s.%s", f.Message.PackageName, f.Message.Name)
+		} else if f.Message.IsPrivate {
+			return fmt.Sprintf("*privatepb.%s", f.Message.Name)
 		}
 		return fmt.Sprintf("*publicpb.%s", f.Message.Name)
 	case EnumType:
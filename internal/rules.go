@@ -11,7 +11,7 @@ import (
 func NewRules(f *Field, validate *svc.Validate) ([]string, error) {
 	var rules []string
 	if validate.GetRequired() {
-		rules = append(rules, "validation.Required")
+		rules = append(rules, f.RequiredRule())
 	}
 
 	if f.Type == MessageType {
@@ -38,6 +38,10 @@ func NewRules(f *Field, validate *svc.Validate) ([]string, error) {
 			if value != "true" && value != "false" {
 				return nil, NewErrInvalidRuleIn(f, value)
 			}
+		case Int32Type:
+			if _, err := strconv.ParseInt(value, 10, 32); err != nil {
+				return nil, NewErrInvalidRuleIn(f, value)
+			}
 		case Int64Type:
 			if _, err := strconv.ParseInt(value, 10, 64); err != nil {
 				return nil, NewErrInvalidRuleIn(f, value)
@@ -74,7 +78,7 @@ func NewRules(f *Field, validate *svc.Validate) ([]string, error) {
 
 	if validate.GetMin() != nil || validate.GetMax() != nil {
 		switch f.Type {
-		case Int64Type:
+		case Int32Type, Int64Type:
 			if value := validate.GetMin(); value != nil {
 				rules = append(rules, fmt.Sprintf("validation.Min(%d)", value.GetInt64()))
 			}
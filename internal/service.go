@@ -5,6 +5,8 @@ import (
 
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/dane/protoc-gen-go-svc/internal/options"
 )
 
 type Service struct {
@@ -23,6 +25,75 @@ type Service struct {
 	MessageByName        map[string]*Message
 	Methods              []*Method
 	MethodByName         map[string]*Method
+	IsPaginated          bool
+	FieldConverters      []*FieldConverter
+	ConversionPackages   []*Message
+
+	// AliasMessageNames are the names of the input and output messages of
+	// alias methods. They are not converted.
+	AliasMessageNames map[string]bool
+
+	// FieldPathKeys are the `ErrorInfo` metadata keys whose values are field
+	// paths.
+	FieldPathKeys []string
+
+	// RegisterPrivates are the private services of the chain in the order
+	// their implementations are passed to RegisterServer.
+	RegisterPrivates []*Service `json:"-"`
+}
+
+// addConversionPackage adds the package of an external message used by a field
+// conversion. Packages already imported by the service are skipped.
+func (s *Service) addConversionPackage(f *Field) {
+	if !f.IsMessage || !f.Message.IsExternal {
+		return
+	}
+
+	for _, msg := range s.Messages {
+		if msg.IsExternal && msg.PackageName == f.Message.PackageName {
+			return
+		}
+	}
+
+	for _, msg := range s.ConversionPackages {
+		if msg.PackageName == f.Message.PackageName {
+			return
+		}
+	}
+
+	s.ConversionPackages = append(s.ConversionPackages, f.Message)
+}
+
+// MethodPackages returns a message of each external package used by the input
+// or output of a method of the service.
+func (s *Service) MethodPackages() []*Message {
+	var packages []*Message
+	seen := make(map[string]bool)
+	for _, m := range s.Methods {
+		for _, msg := range []*Message{m.Input, m.Output} {
+			if !msg.IsExternal || seen[msg.PackageName] {
+				continue
+			}
+
+			seen[msg.PackageName] = true
+			packages = append(packages, msg)
+		}
+	}
+
+	return packages
+}
+
+// Hooks returns the methods of the service that call a user defined hook
+// rather than a private method.
+func (s *Service) Hooks() []*Method {
+	var hooks []*Method
+	for _, m := range s.Methods {
+		if m.IsHook {
+			hooks = append(hooks, m)
+		}
+	}
+
+	return hooks
 }
 
 // NewService creates a `Service`. An error will be returned if the service
@@ -47,6 +118,7 @@ func NewService(
 		Name:                 service.GoName,
 		MessageByName:        make(map[string]*Message),
 		MethodByName:         make(map[string]*Method),
+		AliasMessageNames:    make(map[string]bool),
 	}
 
 	// The private service is the first entry in the chain. If the chain has a
@@ -66,18 +138,42 @@ func NewService(
 		svc.Next = serviceChain[len(serviceChain)-1]
 	}
 
+	// Mark the messages of alias methods before the messages are created.
+	markAliasMessages(svc, service)
+
 	// Create messages. Fields are created after all messages have been created
 	// because oneofs and will reference messages.
 	if err := buildMessages(svc, messages, nil); err != nil {
 		return nil, err
 	}
 
+	// Several messages may be converted to the same private message. Their
+	// converters are named after both messages.
+	markSharedPrivateMessages(svc)
+
+	// Mark the fields used to paginate methods or composed into a field of the
+	// next service before the fields are created.
+	markPaginationFields(svc, service)
+	markComposedFields(svc, messages)
+
 	// Iterate through messages again to ensure all messages are present that a
 	// field may reference.
 	if err := buildMessageFields(svc, messages); err != nil {
 		return nil, err
 	}
 
+	if err := buildCompositions(svc, messages); err != nil {
+		return nil, err
+	}
+
+	if err := markMergedFields(svc); err != nil {
+		return nil, NewErrCreateService(svc, err)
+	}
+
+	if err := buildFieldConverters(svc); err != nil {
+		return nil, NewErrCreateService(svc, err)
+	}
+
 	// Create methods. All messages will be present at this point.
 	for _, method := range service.Methods {
 		// Create unique external messages that are method input or output.
@@ -101,11 +197,41 @@ func NewService(
 
 		svc.Methods = append(svc.Methods, m)
 		svc.MethodByName[methodKey(method)] = m
+
+		if m.Pagination != nil && !svc.IsPrivate {
+			svc.IsPaginated = true
+		}
+	}
+
+	if err := buildAliases(svc, service); err != nil {
+		return nil, NewErrCreateService(svc, err)
 	}
 
 	return svc, nil
 }
 
+func markPaginationFields(svc *Service, service *protogen.Service) {
+	for _, method := range service.Methods {
+		pagination := options.MethodPagination(method)
+		if pagination == nil {
+			continue
+		}
+
+		input, output := paginationFieldNames(pagination.GetStyle())
+		if msg, ok := svc.MessageByName[messageKey(method.Input)]; ok && !msg.IsExternal {
+			for _, name := range input {
+				msg.PaginationFieldNames[name] = true
+			}
+		}
+
+		if msg, ok := svc.MessageByName[messageKey(method.Output)]; ok && !msg.IsExternal {
+			for _, name := range output {
+				msg.PaginationFieldNames[name] = true
+			}
+		}
+	}
+}
+
 func buildMessages(svc *Service, messages []*protogen.Message, parent *protogen.Message) error {
 	for _, message := range messages {
 		msg, err := NewMessage(svc, message, parent)
@@ -145,7 +271,7 @@ func buildMessageFields(svc *Service, messages []*protogen.Message) error {
 			// Skip fields that are part of a oneof. They will be constructed
 			// later in the file. It's easier to create a oneof from the message
 			// struct.
-			if field.Oneof != nil {
+			if field.Oneof != nil && !field.Oneof.Desc.IsSynthetic() {
 				continue
 			}
 
@@ -164,6 +290,12 @@ func buildMessageFields(svc *Service, messages []*protogen.Message) error {
 		}
 
 		for _, oneof := range message.Oneofs {
+			// Synthetic oneofs of proto3 `optional` fields are created as
+			// fields above.
+			if oneof.Desc.IsSynthetic() {
+				continue
+			}
+
 			// All package messages have been created already. There is no need
 			// to guard against the message not being present.
 			msg := svc.MessageByName[messageKey(message)]
@@ -0,0 +1,82 @@
+package internal
+
+import (
+	"strings"
+)
+
+// isAbsoluteMessageName checks if a message delegate name is resolved from the
+// package of the next or private service, such as `.Contact.Address`.
+func isAbsoluteMessageName(name string) bool {
+	return strings.HasPrefix(name, ".")
+}
+
+// PrivateRef returns the name used by the converter of the message to the
+// private message. Messages sharing a private message are converted by a
+// converter named after both messages.
+func (m *Message) PrivateRef() string {
+	if m.IsPrivateShared {
+		return m.Private.Ref() + "From" + m.Ref()
+	}
+
+	return m.Private.Ref()
+}
+
+// markSharedPrivateMessages marks the messages of a service that are converted
+// to the same private message. For example, deprecated `Address` and `Phone`
+// messages may both be converted to a private `Contact` message.
+func markSharedPrivateMessages(svc *Service) {
+	messages := make(map[*Message][]*Message)
+	for _, msg := range svc.Messages {
+		if msg.IsPrivate || msg.IsExternal || msg.IsAlias {
+			continue
+		}
+
+		messages[msg.Private] = append(messages[msg.Private], msg)
+	}
+
+	for _, shared := range messages {
+		if len(shared) < 2 {
+			continue
+		}
+
+		for _, msg := range shared {
+			msg.IsPrivateShared = true
+		}
+	}
+}
+
+// markMergedFields marks the fields of a message that are converted to the
+// same private field. The private messages converted from each field are
+// merged. An error will be returned if a field is not a message field.
+func markMergedFields(svc *Service) error {
+	for _, msg := range svc.Messages {
+		if msg.IsPrivate || msg.IsExternal {
+			continue
+		}
+
+		fields := make(map[*Field][]*Field)
+		for _, f := range msg.ConvertedFields() {
+			if f.Private == nil || f.IsPrivateMoved() {
+				continue
+			}
+
+			fields[f.Private] = append(fields[f.Private], f)
+		}
+
+		for _, merged := range fields {
+			if len(merged) < 2 {
+				continue
+			}
+
+			for _, f := range merged {
+				if !f.IsMessage || f.IsRepeated || f.IsOneOf || f.IsWrapper || f.ConvertPrivate != nil {
+					return NewErrInvalidMergedField(f, msg)
+				}
+
+				f.IsMerged = true
+			}
+		}
+	}
+
+	return nil
+}
@@ -1,6 +1,11 @@
 package internal
 
-import _ "embed"
+import (
+	_ "embed"
+	"os"
+	"path/filepath"
+	"strings"
+)
 
 var (
 	//go:embed templates/register.pb.go.tmpl
@@ -12,6 +17,27 @@ var (
 	//go:embed templates/testing.go.tmpl
 	testingTemplate string
 
+	//go:embed templates/fake.go.tmpl
+	fakeTemplate string
+
+	//go:embed templates/runner.go.tmpl
+	runnerTemplate string
+
+	//go:embed templates/migration.md.tmpl
+	migrationMarkdownTemplate string
+
+	//go:embed templates/migration.dot.tmpl
+	migrationDOTTemplate string
+
+	//go:embed templates/converters.js.tmpl
+	convertersJSTemplate string
+
+	//go:embed templates/converters.d.ts.tmpl
+	convertersTSTemplate string
+
+	//go:embed templates/messages.d.ts.tmpl
+	messagesTSTemplate string
+
 	//go:embed templates/partials/converters.go.tmpl
 	convertersPartial string
 
@@ -26,6 +52,21 @@ var (
 
 	//go:embed templates/partials/impls.go.tmpl
 	implsPartial string
+
+	//go:embed templates/partials/errors.go.tmpl
+	errorsPartial string
+
+	//go:embed templates/partials/pagination.go.tmpl
+	paginationPartial string
+
+	//go:embed templates/partials/hooks.go.tmpl
+	hooksPartial string
+
+	//go:embed templates/partials/aliases.go.tmpl
+	aliasesPartial string
+
+	//go:embed templates/partials/extensions.go.tmpl
+	extensionsPartial string
 )
 
 var Partials = []string{
@@ -34,4 +75,42 @@ var Partials = []string{
 	mutatorsPartial,
 	handlersPartial,
 	implsPartial,
+	errorsPartial,
+	paginationPartial,
+	hooksPartial,
+	aliasesPartial,
+	extensionsPartial,
+}
+
+// TemplateFileSuffix is the suffix of template files read from the directory
+// of the `templates` plugin parameter.
+const TemplateFileSuffix = ".tmpl"
+
+type templateFile struct {
+	Name    string
+	Content string
+}
+
+// readTemplates reads the template files of a directory in order of name.
+func readTemplates(dir string) ([]templateFile, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, NewErrReadTemplates(dir, err)
+	}
+
+	var files []templateFile
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), TemplateFileSuffix) {
+			continue
+		}
+
+		b, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, NewErrReadTemplates(dir, err)
+		}
+
+		files = append(files, templateFile{Name: entry.Name(), Content: string(b)})
+	}
+
+	return files, nil
 }
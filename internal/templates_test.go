@@ -0,0 +1,144 @@
+package internal
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// overrideDir writes template files to a temporary directory.
+func overrideDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestTemplateOverrides(t *testing.T) {
+	const root = `package service
+
+{{ template "page-token-codec" }}
+
+{{ template "service-extension" . }}
+`
+
+	tests := map[string]struct {
+		Files   map[string]string
+		Want    []string
+		NotWant []string
+	}{
+		"built-in templates": {
+			Want:    []string{"func NewPageTokenCodec() PageTokenCodec"},
+			NotWant: []string{"func Extension()"},
+		},
+		"redefined partial": {
+			Files: map[string]string{
+				"codec.tmpl": `{{ define "page-token-codec" }}type PageTokenCodec interface{ Custom() }{{ end }}`,
+			},
+			Want:    []string{"type PageTokenCodec interface{ Custom() }"},
+			NotWant: []string{"func NewPageTokenCodec() PageTokenCodec"},
+		},
+		"extension point": {
+			Files: map[string]string{
+				"extension.tmpl": `{{ define "service-extension" }}func Extension() string { return {{ printf "%q" .PackageName }} }{{ end }}`,
+				"ignored.txt":    `{{ define "service-extension" }}func Ignored() {}{{ end }}`,
+			},
+			Want:    []string{"func NewPageTokenCodec() PageTokenCodec", `func Extension() string { return "v1" }`},
+			NotWant: []string{"func Ignored()"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			files, err := readTemplates(overrideDir(t, test.Files))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p := &Plugin{overrides: files}
+
+			var buf bytes.Buffer
+			if err := p.render(&buf, "service", root, &Service{PackageName: "v1"}); err != nil {
+				t.Fatal(err)
+			}
+
+			for _, want := range test.Want {
+				if !strings.Contains(buf.String(), want) {
+					t.Fatalf("expected %q in output:\n%s", want, buf.String())
+				}
+			}
+
+			for _, notWant := range test.NotWant {
+				if strings.Contains(buf.String(), notWant) {
+					t.Fatalf("unexpected %q in output:\n%s", notWant, buf.String())
+				}
+			}
+		})
+	}
+}
+
+func TestTemplateOverridesArePerPlugin(t *testing.T) {
+	files, err := readTemplates(overrideDir(t, map[string]string{
+		"extension.tmpl": `{{ define "register-extension" }}func Extension() {}{{ end }}`,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	register := RegisterService{PackageName: "service"}
+	for _, test := range []struct {
+		Plugin *Plugin
+		Want   bool
+	}{
+		{Plugin: &Plugin{overrides: files}, Want: true},
+		{Plugin: &Plugin{}, Want: false},
+	} {
+		var buf bytes.Buffer
+		if err := test.Plugin.render(&buf, "register", registerTemplate, register); err != nil {
+			t.Fatal(err)
+		}
+
+		if got := strings.Contains(buf.String(), "func Extension() {}"); got != test.Want {
+			t.Fatalf("expected extension %t, got %t in output:\n%s", test.Want, got, buf.String())
+		}
+	}
+}
+
+func TestTemplateOverridesAliases(t *testing.T) {
+	files, err := readTemplates(overrideDir(t, map[string]string{
+		"aliases.tmpl": `{{ define "aliases" }}{{ range . }}// {{ .Name }}({{ .Input.Type }}) calls {{ .Alias.Method.Name }} for each {{ .Alias.Input.Name }}.{{ end }}{{ end }}`,
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	create := &Method{Name: "Create", Input: &Message{Name: "CreateRequest"}}
+	batch := &Method{
+		IsAlias: true,
+		Name:    "Batch",
+		Input:   &Message{Name: "BatchRequest"},
+		Alias: &Alias{
+			Method: create,
+			Input:  &Field{Name: "Creates"},
+		},
+	}
+
+	p := &Plugin{overrides: files}
+
+	var buf bytes.Buffer
+	if err := p.render(&buf, "service", `{{ template "aliases" .Aliases }}`, &Service{Methods: []*Method{create, batch}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "// Batch(publicpb.BatchRequest) calls Create for each Creates."; buf.String() != want {
+		t.Fatalf("expected %q, got %q", want, buf.String())
+	}
+}
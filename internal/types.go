@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 type Type int
@@ -11,6 +12,7 @@ type Type int
 const (
 	Undefined Type = iota
 	StringType
+	Int32Type
 	Int64Type
 	Uint64Type
 	Float64Type
@@ -21,6 +23,45 @@ const (
 	OneOfType
 )
 
+// wrapperTypes are the `google.protobuf.*Value` messages that wrap a scalar
+// value to track presence, keyed by message name.
+var wrapperTypes = map[protoreflect.FullName]Type{
+	"google.protobuf.StringValue": StringType,
+	"google.protobuf.Int32Value":  Int32Type,
+	"google.protobuf.Int64Value":  Int64Type,
+	"google.protobuf.UInt64Value": Uint64Type,
+	"google.protobuf.DoubleValue": Float64Type,
+	"google.protobuf.BoolValue":   BooleanType,
+	"google.protobuf.BytesValue":  BytesType,
+}
+
+func (t Type) String() string {
+	switch t {
+	case StringType:
+		return "string"
+	case Int32Type:
+		return "int32"
+	case Int64Type:
+		return "int64"
+	case Uint64Type:
+		return "uint64"
+	case Float64Type:
+		return "float64"
+	case BooleanType:
+		return "bool"
+	case BytesType:
+		return "bytes"
+	case MessageType:
+		return "message"
+	case EnumType:
+		return "enum"
+	case OneOfType:
+		return "oneof"
+	}
+
+	return "undefined"
+}
+
 func methodKey(method *protogen.Method) string {
 	return string(method.Desc.Name())
 }
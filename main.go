@@ -13,7 +13,22 @@ func main() {
 	var flags flag.FlagSet
 
 	flags.BoolVar(&gen.Verbose, "verbose", false, "enable verbose logging")
-	flags.StringVar(&gen.PrivatePackageName, "private_package", "private", "name of private service package")
+	flags.StringVar(&gen.PrivatePackageName, "private_package", "", "name of private service package, defaults to the package named private or the only package ending in .private")
+	flags.Func("chain", "semicolon separated public service packages from oldest to latest, may be repeated", func(value string) error {
+		if gen.Chain != "" {
+			gen.Chain += ";"
+		}
+
+		gen.Chain += value
+		return nil
+	})
+	flags.BoolVar(&gen.Docs, "docs", false, "write a migration map of each chain in Markdown and DOT")
+	flags.BoolVar(&gen.IR, "ir", false, "write the intermediate representation of each chain in JSON")
+	flags.BoolVar(&gen.JavaScript, "js", false, "write JavaScript converters with TypeScript declarations of each service version")
+	flags.BoolVar(&gen.Lint, "lint", false, "report suspicious annotations of each chain without generating code")
+	flags.StringVar(&gen.FieldNumbers, "field_numbers", "", "fail generation on, warn of or ignore field numbers reserved or reused across versions, defaults to warn")
+	flags.StringVar(&gen.FieldPathKeys, "field_path_keys", "field", "semicolon separated ErrorInfo metadata keys holding field paths, defaults to field")
+	flags.StringVar(&gen.TemplatesDir, "templates", "", "directory of template files overriding or extending the built-in templates")
 
 	opt := protogen.Options{ParamFunc: flags.Set}
 	opt.Run(gen.Run)